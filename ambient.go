@@ -0,0 +1,167 @@
+package fault
+
+import (
+	"context"
+	"slices"
+)
+
+// AmbientField is a value carried by a context.Context that is copied into the context
+// of every fault created from that context.Context. (See NewCtx.)
+type AmbientField struct {
+	// Key is the key of the field in the fault's context.
+	Key string
+
+	// Value is the value of the field.
+	Value any
+
+	// Apply, if not nil, is called instead of setting Key to Value on the fault. It allows
+	// to set the key along with its options. (e.g., marking it as sensitive)
+	Apply func(base *BaseFault)
+}
+
+// ambientKey is the key under which the ambient fields are stored in a context.Context.
+type ambientKey struct{}
+
+// ambientNode is a node of the immutable list of ambient fields of a context.Context.
+type ambientNode struct {
+	// parent is the node of the enclosing context.Context. Nil if there is none.
+	parent *ambientNode
+
+	// field is the field of this node.
+	field AmbientField
+}
+
+// WithAmbient returns a copy of ctx that carries the given field. A field shadows the
+// fields with the same key of the enclosing contexts.
+//
+// Parameters:
+//   - ctx: The parent context. If nil, context.Background() is used.
+//   - field: The field to carry.
+//
+// Returns:
+//   - context.Context: The new context. Never returns nil.
+func WithAmbient(ctx context.Context, field AmbientField) context.Context {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	parent, _ := ctx.Value(ambientKey{}).(*ambientNode)
+
+	return context.WithValue(ctx, ambientKey{}, &ambientNode{
+		parent: parent,
+		field:  field,
+	})
+}
+
+// AmbientFields returns the fields carried by ctx, from the outermost to the innermost.
+// Shadowed fields are not returned.
+//
+// Parameters:
+//   - ctx: The context to get the fields of.
+//
+// Returns:
+//   - []AmbientField: The fields carried by ctx. Nil if there are none.
+func AmbientFields(ctx context.Context) []AmbientField {
+	if ctx == nil {
+		return nil
+	}
+
+	node, _ := ctx.Value(ambientKey{}).(*ambientNode)
+
+	var fields []AmbientField
+
+	seen := make(map[string]struct{})
+
+	for ; node != nil; node = node.parent {
+		_, ok := seen[node.field.Key]
+		if ok {
+			continue
+		}
+
+		seen[node.field.Key] = struct{}{}
+
+		fields = append(fields, node.field)
+	}
+
+	slices.Reverse(fields)
+
+	return fields
+}
+
+// AmbientValue returns the value of the innermost field with the given key carried by ctx.
+//
+// Parameters:
+//   - ctx: The context to look into.
+//   - key: The key of the field.
+//
+// Returns:
+//   - any: The value of the field.
+//   - bool: True if the field exists, false otherwise.
+func AmbientValue(ctx context.Context, key string) (any, bool) {
+	if ctx == nil {
+		return nil, false
+	}
+
+	node, _ := ctx.Value(ambientKey{}).(*ambientNode)
+
+	for ; node != nil; node = node.parent {
+		if node.field.Key == key {
+			return node.field.Value, true
+		}
+	}
+
+	return nil, false
+}
+
+// ApplyAmbient copies the fields carried by ctx into the context of the base fault.
+//
+// Parameters:
+//   - ctx: The context that carries the fields.
+//   - base: The base fault to copy the fields into.
+//
+// Returns:
+//   - bool: True if the fields were copied, false if base is nil.
+func ApplyAmbient(ctx context.Context, base *BaseFault) bool {
+	if base == nil {
+		return false
+	}
+
+	for _, field := range AmbientFields(ctx) {
+		if field.Apply != nil {
+			field.Apply(base)
+		} else {
+			base.SetKey(field.Key, field.Value)
+		}
+	}
+
+	return true
+}
+
+// NewCtx is like New but the fields carried by ctx are copied into the fault's context.
+// (See WithAmbient.) The fault is timestamped with the clock scoped to ctx, if any. (See
+// WithClock.) The CreateEvent is emitted, once the fields are copied, to both
+// GlobalHooks and the hooks scoped to ctx. (See WithHooks.)
+//
+// Parameters:
+//   - ctx: The context that carries the ambient fields.
+//   - code: The code of the fault.
+//   - msg: The message of the fault.
+//
+// Returns:
+//   - Fault: The new Fault. Never returns nil.
+//
+// The level of the fault is set to ERROR.
+func NewCtx[C FaultCode](ctx context.Context, code C, msg string) Fault {
+	desc := &faultDescriptor[C]{
+		level: ERROR,
+		code:  code,
+		msg:   msg,
+	}
+
+	fault := desc.newFault(ClockFrom(ctx).Now())
+	_ = ApplyAmbient(ctx, fault)
+
+	EmitCreate(ctx, fault)
+
+	return fault
+}
@@ -0,0 +1,170 @@
+package fault
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+)
+
+// Clock gives the current time. It is used to timestamp the faults when they are created.
+type Clock interface {
+	// Now returns the current time.
+	//
+	// Returns:
+	//   - time.Time: The current time.
+	Now() time.Time
+}
+
+// ClockFunc is a function that implements the Clock interface.
+type ClockFunc func() time.Time
+
+// Now implements the Clock interface.
+func (fn ClockFunc) Now() time.Time {
+	return fn()
+}
+
+const (
+	// DefaultTimestampFormat is the default layout of the timestamps of the rendered
+	// faults. It is the layout of time.Time.String without the monotonic clock reading.
+	DefaultTimestampFormat string = "2006-01-02 15:04:05.999999999 -0700 MST"
+)
+
+var (
+	// SystemClock is the clock that gives the time of the system; through time.Now.
+	SystemClock Clock = ClockFunc(time.Now)
+)
+
+// clockBox boxes a Clock so that it can be stored atomically.
+type clockBox struct {
+	// clock is the boxed clock.
+	clock Clock
+}
+
+var (
+	// global_clock is the clock used when no clock is scoped to the context. Never nil.
+	global_clock atomic.Pointer[clockBox]
+
+	// timestamp_format is the layout of the timestamps of the rendered faults.
+	timestamp_format atomic.Pointer[string]
+)
+
+func init() {
+	global_clock.Store(&clockBox{clock: SystemClock})
+
+	format := DefaultTimestampFormat
+	timestamp_format.Store(&format)
+}
+
+// SetClock sets the clock used to timestamp the faults; unless a clock is scoped to the
+// context they are created with. (See WithClock.)
+//
+// Parameters:
+//   - clock: The new clock. If nil, SystemClock is used.
+//
+// Returns:
+//   - func(): The function that restores the previous clock. Never returns nil.
+//
+// Example:
+//
+//	defer fault.SetClock(clock)()
+func SetClock(clock Clock) func() {
+	if clock == nil {
+		clock = SystemClock
+	}
+
+	old := global_clock.Swap(&clockBox{clock: clock})
+
+	return func() {
+		global_clock.Store(old)
+	}
+}
+
+// GetClock returns the clock used to timestamp the faults. (See SetClock.)
+//
+// Returns:
+//   - Clock: The clock. Never returns nil.
+func GetClock() Clock {
+	return global_clock.Load().clock
+}
+
+// Now returns the current time according to the global clock. (See SetClock.)
+//
+// Returns:
+//   - time.Time: The current time.
+func Now() time.Time {
+	return GetClock().Now()
+}
+
+// clockKey is the key under which the scoped clock is stored in a context.Context.
+type clockKey struct{}
+
+// WithClock returns a copy of ctx whose faults are timestamped with the given clock
+// instead of the global one. (See NewCtx.)
+//
+// Parameters:
+//   - ctx: The parent context. If nil, context.Background() is used.
+//   - clock: The scoped clock.
+//
+// Returns:
+//   - context.Context: The new context. Never returns nil.
+func WithClock(ctx context.Context, clock Clock) context.Context {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	if clock == nil {
+		return ctx
+	}
+
+	return context.WithValue(ctx, clockKey{}, clock)
+}
+
+// ClockFrom returns the clock scoped to ctx or, if there is none, the global clock.
+//
+// Parameters:
+//   - ctx: The context to look into. May be nil.
+//
+// Returns:
+//   - Clock: The clock. Never returns nil.
+func ClockFrom(ctx context.Context) Clock {
+	if ctx != nil {
+		clock, ok := ctx.Value(clockKey{}).(Clock)
+		if ok {
+			return clock
+		}
+	}
+
+	return GetClock()
+}
+
+// SetTimestampFormat sets the layout, as understood by time.Time.Format, of the
+// timestamps of the rendered faults.
+//
+// Parameters:
+//   - layout: The new layout. If empty, DefaultTimestampFormat is used.
+//
+// Returns:
+//   - func(): The function that restores the previous layout. Never returns nil.
+func SetTimestampFormat(layout string) func() {
+	if layout == "" {
+		layout = DefaultTimestampFormat
+	}
+
+	old := timestamp_format.Swap(&layout)
+
+	return func() {
+		timestamp_format.Store(old)
+	}
+}
+
+// FormatTimestamp renders the timestamp of a fault with the layout set by
+// SetTimestampFormat. The monotonic clock reading, if any, is never rendered.
+//
+// Parameters:
+//   - timestamp: The timestamp to render.
+//
+// Returns:
+//   - string: The rendered timestamp.
+func FormatTimestamp(timestamp time.Time) string {
+	return timestamp.Round(0).Format(*timestamp_format.Load())
+}
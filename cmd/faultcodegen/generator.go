@@ -0,0 +1,218 @@
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"strconv"
+)
+
+// levelIdents maps the upper-case names and aliases of the built-in levels, as accepted
+// by fault.ParseLevel, to their identifiers. The fault package is not imported; so that
+// the generator still builds when the files it generates are stale.
+var levelIdents map[string]string
+
+func init() {
+	levelIdents = map[string]string{
+		"FATAL":   "FATAL",
+		"ERROR":   "ERROR",
+		"ERR":     "ERROR",
+		"WARNING": "WARNING",
+		"WARN":    "WARNING",
+		"NOTICE":  "NOTICE",
+		"DEBUG":   "DEBUG",
+	}
+}
+
+// generator writes the generated file.
+type generator struct {
+	// buf is the content of the file.
+	buf bytes.Buffer
+
+	// qual is the qualifier of the identifiers of the fault package. Empty if the
+	// generated code is part of the fault package.
+	qual string
+}
+
+// newGenerator creates a new generator and writes the header of the file.
+//
+// Parameters:
+//   - p: The package the generated file is part of.
+//   - command: The command that generates the file.
+//
+// Returns:
+//   - *generator: The new generator. Never returns nil.
+func newGenerator(p *pkg, command string) *generator {
+	g := &generator{
+		qual: "flt.",
+	}
+
+	if p.types.Path() == faultPath {
+		g.qual = ""
+	}
+
+	g.printf("// Code generated by %q; DO NOT EDIT.\n\n", command)
+	g.printf("package %s\n\n", p.name)
+	g.printf("import (\n")
+	g.printf("\t\"fmt\"\n")
+	g.printf("\t\"strconv\"\n")
+	g.printf("\t\"strings\"\n")
+
+	if g.qual != "" {
+		g.printf("\n\tflt %q\n", faultPath)
+	}
+
+	g.printf(")\n")
+
+	return g
+}
+
+// printf appends formatted text to the file.
+//
+// Parameters:
+//   - format: The format of the text.
+//   - args: The arguments of the format.
+func (g *generator) printf(format string, args ...any) {
+	fmt.Fprintf(&g.buf, format, args...)
+}
+
+// generate writes the declarations of the code type.
+//
+// Parameters:
+//   - ct: The code type.
+func (g *generator) generate(ct codeType) {
+	t := ct.name
+
+	g.printf("\nfunc _() {\n")
+	g.printf("\t// An \"invalid array index\" compiler error signifies that the constant values have changed.\n")
+	g.printf("\t// Re-run the faultcodegen command to generate them again.\n")
+	g.printf("\tvar x [1]struct{}\n")
+
+	for _, c := range ct.consts {
+		g.printf("\t_ = x[%s-(%d)]\n", c.ident, c.value)
+	}
+
+	g.printf("}\n")
+
+	g.printf("\n// _%s_values are the values of %s, in order of declaration.\n", t, t)
+	g.printf("var _%s_values = [...]%s{\n", t, t)
+
+	for _, c := range ct.consts {
+		g.printf("\t%s,\n", c.ident)
+	}
+
+	g.printf("}\n")
+
+	g.printf("\n// _%s_names are the names of the values of %s.\n", t, t)
+	g.printf("var _%s_names = [...]string{\n", t)
+
+	for _, c := range ct.consts {
+		g.printf("\t%s,\n", strconv.Quote(c.name))
+	}
+
+	g.printf("}\n")
+
+	g.printf("\n// _%s_infos are the metadata of the values of %s.\n", t, t)
+	g.printf("var _%s_infos = [...]%sCodeInfo{\n", t, g.qual)
+
+	for _, c := range ct.consts {
+		g.printf("\t{\n")
+		g.printf("\t\tDescription: %s,\n", strconv.Quote(c.info.description))
+		g.printf("\t\tLevel: %s%s,\n", g.qual, c.info.level)
+		g.printf("\t\tHTTPStatus: %d,\n", c.info.http_status)
+		g.printf("\t\tDocURL: %s,\n", strconv.Quote(c.info.doc_url))
+		g.printf("\t},\n")
+	}
+
+	g.printf("}\n")
+
+	g.printf("\n// _%s_index returns the index of the value in the tables of %s; -1 if the\n", t, t)
+	g.printf("// value is unknown.\n")
+	g.printf("func _%s_index(i %s) int {\n", t, t)
+	g.printf("\tswitch i {\n")
+
+	for idx, c := range ct.consts {
+		g.printf("\tcase %s:\n\t\treturn %d\n", c.ident, idx)
+	}
+
+	g.printf("\tdefault:\n\t\treturn -1\n\t}\n}\n")
+
+	g.printf("\n// String implements the fmt.Stringer interface.\n")
+	g.printf("func (i %s) String() string {\n", t)
+	g.printf("\tidx := _%s_index(i)\n", t)
+	g.printf("\tif idx < 0 {\n")
+	g.printf("\t\treturn %q + strconv.FormatInt(int64(i), 10) + \")\"\n", t+"(")
+	g.printf("\t}\n\n")
+	g.printf("\treturn _%s_names[idx]\n}\n", t)
+
+	g.printf("\n// Parse%s parses a %s from its name, as given by String, or from the\n", t, t)
+	g.printf("// name of its constant. Unknown values, such as %q, are accepted as well.\n", t+"(42)")
+	g.printf("func Parse%s(str string) (%s, error) {\n", t, t)
+	g.printf("\tstr = strings.TrimSpace(str)\n\n")
+	g.printf("\tswitch str {\n")
+
+	for _, c := range ct.consts {
+		if c.name == c.ident {
+			g.printf("\tcase %s:\n", strconv.Quote(c.name))
+		} else {
+			g.printf("\tcase %s, %s:\n", strconv.Quote(c.name), strconv.Quote(c.ident))
+		}
+
+		g.printf("\t\treturn %s, nil\n", c.ident)
+	}
+
+	g.printf("\t}\n\n")
+	g.printf("\tdigits, ok := strings.CutPrefix(str, %q)\n", t+"(")
+	g.printf("\tif ok {\n")
+	g.printf("\t\tdigits, ok = strings.CutSuffix(digits, \")\")\n")
+	g.printf("\t}\n\n")
+	g.printf("\tif ok {\n")
+	g.printf("\t\tvalue, err := strconv.ParseInt(digits, 10, 64)\n")
+	g.printf("\t\tif err == nil {\n")
+	g.printf("\t\t\treturn %s(value), nil\n", t)
+	g.printf("\t\t}\n")
+	g.printf("\t}\n\n")
+	g.printf("\treturn 0, fmt.Errorf(\"unknown %s (%%q)\", str)\n}\n", t)
+
+	g.printf("\n// MarshalText implements the encoding.TextMarshaler interface.\n")
+	g.printf("func (i %s) MarshalText() ([]byte, error) {\n", t)
+	g.printf("\treturn []byte(i.String()), nil\n}\n")
+
+	g.printf("\n// UnmarshalText implements the encoding.TextUnmarshaler interface. The text is parsed\n")
+	g.printf("// with Parse%s.\n", t)
+	g.printf("func (i *%s) UnmarshalText(text []byte) error {\n", t)
+	g.printf("\tif i == nil {\n")
+	g.printf("\t\treturn fmt.Errorf(\"receiver must be non-nil\")\n")
+	g.printf("\t}\n\n")
+	g.printf("\tvalue, err := Parse%s(string(text))\n", t)
+	g.printf("\tif err != nil {\n\t\treturn err\n\t}\n\n")
+	g.printf("\t*i = value\n\n")
+	g.printf("\treturn nil\n}\n")
+
+	g.printf("\n// %sValues returns the values of %s, in order of declaration.\n", t, t)
+	g.printf("func %sValues() []%s {\n", t, t)
+	g.printf("\tvalues := _%s_values\n\n", t)
+	g.printf("\treturn values[:]\n}\n")
+
+	g.printf("\n// Info implements the %sCodeInfoer interface.\n", g.qual)
+	g.printf("func (i %s) Info() %sCodeInfo {\n", t, g.qual)
+	g.printf("\tidx := _%s_index(i)\n", t)
+	g.printf("\tif idx < 0 {\n")
+	g.printf("\t\treturn %sCodeInfo{Level: %sUnknownLevel}\n", g.qual, g.qual)
+	g.printf("\t}\n\n")
+	g.printf("\treturn _%s_infos[idx]\n}\n", t)
+}
+
+// format returns the gofmt-ed content of the file.
+//
+// Returns:
+//   - []byte: The content of the file.
+//   - error: An error if the generated code is invalid.
+func (g *generator) format() ([]byte, error) {
+	src, err := format.Source(g.buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("generated code is invalid: %w", err)
+	}
+
+	return src, nil
+}
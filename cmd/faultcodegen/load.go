@@ -0,0 +1,359 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"go/ast"
+	"go/build"
+	"go/constant"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+const (
+	// faultPath is the import path of the fault package.
+	faultPath string = "github.com/PlayerR9/go-fault"
+)
+
+// pkg is a parsed and type-checked package.
+type pkg struct {
+	// name is the name of the package.
+	name string
+
+	// files are the parsed files of the package.
+	files []*ast.File
+
+	// types is the type-checked package.
+	types *types.Package
+
+	// info is the type information of the package.
+	info *types.Info
+}
+
+// load parses and type-checks the package in the directory. The test files and the
+// output file are not loaded; the latter so that stale generated code does not get in
+// the way. Type errors are ignored as the package may not compile without the generated
+// code.
+//
+// Parameters:
+//   - dir: The directory of the package.
+//   - output: The path of the output file.
+//
+// Returns:
+//   - *pkg: The loaded package. Nil if an error occurred.
+//   - error: The error that occurred while loading the package, if any.
+func load(dir, output string) (*pkg, error) {
+	bp, err := build.ImportDir(dir, 0)
+	if err != nil {
+		return nil, fmt.Errorf("could not load %s: %w", dir, err)
+	}
+
+	abs_output, _ := filepath.Abs(output)
+
+	fset := token.NewFileSet()
+
+	p := &pkg{
+		name: bp.Name,
+		info: &types.Info{
+			Defs: make(map[*ast.Ident]types.Object),
+		},
+	}
+
+	for _, name := range bp.GoFiles {
+		path := filepath.Join(dir, name)
+
+		abs, _ := filepath.Abs(path)
+		if abs == abs_output {
+			continue
+		}
+
+		file, err := parser.ParseFile(fset, path, nil, parser.ParseComments)
+		if err != nil {
+			return nil, fmt.Errorf("could not parse %s: %w", path, err)
+		}
+
+		p.files = append(p.files, file)
+	}
+
+	conf := types.Config{
+		Importer: importer.ForCompiler(fset, "source", nil),
+		Error:    func(error) {},
+	}
+
+	p.types, _ = conf.Check(importPath(dir), fset, p.files, p.info)
+
+	return p, nil
+}
+
+// importPath returns the import path of the directory; from the nearest go.mod file.
+//
+// Parameters:
+//   - dir: The directory.
+//
+// Returns:
+//   - string: The import path. The directory itself if no go.mod file is found.
+func importPath(dir string) string {
+	abs, err := filepath.Abs(dir)
+	if err != nil {
+		return dir
+	}
+
+	for root := abs; ; {
+		module := modulePath(filepath.Join(root, "go.mod"))
+		if module != "" {
+			rel, err := filepath.Rel(root, abs)
+			if err != nil || rel == "." {
+				return module
+			}
+
+			return module + "/" + filepath.ToSlash(rel)
+		}
+
+		parent := filepath.Dir(root)
+		if parent == root {
+			return dir
+		}
+
+		root = parent
+	}
+}
+
+// modulePath returns the module path declared in the go.mod file.
+//
+// Parameters:
+//   - path: The path of the go.mod file.
+//
+// Returns:
+//   - string: The module path. Empty if the file does not exist or declares no module.
+func modulePath(path string) string {
+	file, err := os.Open(path)
+	if err != nil {
+		return ""
+	}
+
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+
+		module, ok := strings.CutPrefix(line, "module ")
+		if ok {
+			return strings.Trim(strings.TrimSpace(module), `"`)
+		}
+	}
+
+	return ""
+}
+
+// codeConst is a constant of a code type.
+type codeConst struct {
+	// ident is the identifier of the constant.
+	ident string
+
+	// name is the name given by String.
+	name string
+
+	// value is the value of the constant.
+	value int64
+
+	// info is the metadata of the constant.
+	info codeInfo
+}
+
+// codeInfo is the metadata of a constant; as written in the generated fault.CodeInfo.
+type codeInfo struct {
+	// description is the description of the code.
+	description string
+
+	// level is the identifier of the default level. (e.g., "ERROR")
+	level string
+
+	// http_status is the HTTP status. 0 if unset.
+	http_status int
+
+	// doc_url is the URL of the documentation. Empty if unset.
+	doc_url string
+}
+
+// codeType is a code type and its constants.
+type codeType struct {
+	// name is the name of the type.
+	name string
+
+	// consts are the constants of the type, in order of declaration. Constants that
+	// repeat the value of a previous one are omitted.
+	consts []codeConst
+}
+
+// codeType collects the constants of the code type with the given name.
+//
+// Parameters:
+//   - name: The name of the type.
+//
+// Returns:
+//   - codeType: The code type.
+//   - error: An error if the type is not an integer type declared in the package or if
+//     its metadata is invalid.
+func (p *pkg) codeType(name string) (codeType, error) {
+	obj, ok := p.types.Scope().Lookup(name).(*types.TypeName)
+	if !ok {
+		return codeType{}, fmt.Errorf("type %s is not declared in package %s", name, p.name)
+	}
+
+	basic, ok := obj.Type().Underlying().(*types.Basic)
+	if !ok || basic.Info()&types.IsInteger == 0 {
+		return codeType{}, fmt.Errorf("type %s is not an integer type", name)
+	}
+
+	ct := codeType{
+		name: name,
+	}
+
+	seen := make(map[int64]struct{})
+
+	for _, file := range p.files {
+		for _, decl := range file.Decls {
+			gd, ok := decl.(*ast.GenDecl)
+			if !ok || gd.Tok != token.CONST {
+				continue
+			}
+
+			for _, spec := range gd.Specs {
+				vs := spec.(*ast.ValueSpec)
+
+				doc := vs.Doc
+				if doc == nil && len(gd.Specs) == 1 {
+					doc = gd.Doc
+				}
+
+				for _, ident := range vs.Names {
+					c, ok := p.info.Defs[ident].(*types.Const)
+					if !ok || ident.Name == "_" || !types.Identical(c.Type(), obj.Type()) {
+						continue
+					}
+
+					value, ok := constant.Int64Val(c.Val())
+					if !ok {
+						continue
+					}
+
+					_, ok = seen[value]
+					if ok {
+						continue
+					}
+
+					seen[value] = struct{}{}
+
+					cc, err := newCodeConst(ident.Name, value, doc, vs.Comment)
+					if err != nil {
+						return codeType{}, err
+					}
+
+					ct.consts = append(ct.consts, cc)
+				}
+			}
+		}
+	}
+
+	if len(ct.consts) == 0 {
+		return codeType{}, fmt.Errorf("type %s has no constants", name)
+	}
+
+	return ct, nil
+}
+
+// newCodeConst creates a constant of a code type and reads its metadata from its
+// comments.
+//
+// Parameters:
+//   - ident: The identifier of the constant.
+//   - value: The value of the constant.
+//   - doc: The doc comment of the constant. May be nil.
+//   - comment: The line comment of the constant. May be nil.
+//
+// Returns:
+//   - codeConst: The constant.
+//   - error: An error if the metadata is invalid.
+func newCodeConst(ident string, value int64, doc, comment *ast.CommentGroup) (codeConst, error) {
+	cc := codeConst{
+		ident: ident,
+		name:  ident,
+		value: value,
+		info: codeInfo{
+			level: "ERROR",
+		},
+	}
+
+	var line string
+
+	if comment != nil {
+		line = strings.Trim(strings.TrimSpace(comment.Text()), "`")
+	}
+
+	if strings.Contains(line, `:"`) {
+		tag := reflect.StructTag(line)
+
+		cc.info.description = tag.Get("desc")
+		cc.info.doc_url = tag.Get("doc")
+
+		name, ok := tag.Lookup("name")
+		if ok && name != "" {
+			cc.name = name
+		}
+
+		level, ok := tag.Lookup("level")
+		if ok {
+			parsed, ok := levelIdents[strings.ToUpper(strings.TrimSpace(level))]
+			if !ok {
+				return cc, fmt.Errorf("invalid level of %s (%q); use one of FATAL, ERROR, WARNING, NOTICE or DEBUG", ident, level)
+			}
+
+			cc.info.level = parsed
+		}
+
+		status, ok := tag.Lookup("http")
+		if ok {
+			parsed, err := strconv.Atoi(status)
+			if err != nil || parsed < 100 || parsed > 599 {
+				return cc, fmt.Errorf("invalid HTTP status of %s (%q); use a status between 100 and 599, such as 404", ident, status)
+			}
+
+			cc.info.http_status = parsed
+		}
+	} else {
+		cc.info.description = line
+	}
+
+	if cc.info.description == "" && doc != nil {
+		cc.info.description = firstSentence(doc.Text())
+	}
+
+	return cc, nil
+}
+
+// firstSentence returns the first sentence of a comment.
+//
+// Parameters:
+//   - text: The text of the comment.
+//
+// Returns:
+//   - string: The first sentence, on a single line.
+func firstSentence(text string) string {
+	text = strings.Join(strings.Fields(text), " ")
+
+	idx := strings.Index(text, ". ")
+	if idx >= 0 {
+		return text[:idx+1]
+	}
+
+	return text
+}
@@ -0,0 +1,150 @@
+// Command faultcodegen generates the methods of fault code types; in place of stringer.
+//
+// Usage:
+//
+//	faultcodegen -type=<Type>[,<Type>...] [-output=<file>] [dir]
+//
+// For each type, the generated file declares:
+//
+//	func (i T) String() string
+//	func ParseT(str string) (T, error)
+//	func (i T) MarshalText() ([]byte, error)
+//	func (i *T) UnmarshalText(text []byte) error
+//	func TValues() []T
+//	func (i T) Info() fault.CodeInfo
+//
+// The metadata returned by Info is read from the comments of the constants. A line
+// comment written as a struct tag sets the fields explicitly:
+//
+//	BadParameter // level:"ERROR" http:"400" doc:"https://example.com/bad-parameter"
+//
+// The recognized keys are "desc" (the description), "level" (the default level, ERROR if
+// omitted), "http" (the HTTP status), "doc" (the documentation URL) and "name" (the name
+// used by String and ParseT, the constant's name if omitted). Any other line comment is
+// the description. Without a description, the first sentence of the doc comment is used.
+//
+// It only depends on the standard library; so that it still builds, and can repair them,
+// when the files it generates are stale. It is meant to be run by go generate:
+//
+//	//go:generate go run github.com/PlayerR9/go-fault/cmd/faultcodegen -type=StandardCode
+//
+// The output file defaults to "<type>_string.go", in lower case, in the directory of the
+// package. (The current directory if omitted.)
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stderr))
+}
+
+// run runs faultcodegen.
+//
+// Parameters:
+//   - args: The command-line arguments, without the program name.
+//   - stderr: The standard error.
+//
+// Returns:
+//   - int: The exit code.
+func run(args []string, stderr io.Writer) int {
+	fs := flag.NewFlagSet("faultcodegen", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+
+	type_names := fs.String("type", "", "the comma-separated `LIST` of the code types; required")
+	output := fs.String("output", "", "the output `FILE`; defaults to <type>_string.go")
+
+	fs.Usage = func() {
+		fmt.Fprintln(stderr, "Usage: faultcodegen -type=<Type>[,<Type>...] [-output=<file>] [dir]")
+		fmt.Fprintln(stderr)
+		fs.PrintDefaults()
+	}
+
+	err := fs.Parse(args)
+	if err != nil {
+		return 2
+	}
+
+	var names []string
+
+	for _, name := range strings.Split(*type_names, ",") {
+		name = strings.TrimSpace(name)
+		if name != "" {
+			names = append(names, name)
+		}
+	}
+
+	if len(names) == 0 || fs.NArg() > 1 {
+		fs.Usage()
+
+		return 2
+	}
+
+	dir := "."
+	if fs.NArg() == 1 {
+		dir = fs.Arg(0)
+	}
+
+	err = generate(dir, names, *output)
+	if err != nil {
+		fmt.Fprintln(stderr, "faultcodegen:", err)
+
+		return 1
+	}
+
+	return 0
+}
+
+// generate generates the methods of the code types and writes them to the output file.
+//
+// Parameters:
+//   - dir: The directory of the package that declares the types.
+//   - names: The names of the types.
+//   - output: The output file. If empty, "<type>_string.go" is used; where <type> is the
+//     first type in lower case.
+//
+// Returns:
+//   - error: The error that occurred, if any.
+func generate(dir string, names []string, output string) error {
+	if output == "" {
+		output = strings.ToLower(names[0]) + "_string.go"
+	}
+
+	if !filepath.IsAbs(output) && filepath.Dir(output) == "." {
+		output = filepath.Join(dir, output)
+	}
+
+	p, err := load(dir, output)
+	if err != nil {
+		return err
+	}
+
+	g := newGenerator(p, "faultcodegen "+strings.Join(os.Args[1:], " "))
+
+	for _, name := range names {
+		ct, err := p.codeType(name)
+		if err != nil {
+			return err
+		}
+
+		g.generate(ct)
+	}
+
+	src, err := g.format()
+	if err != nil {
+		return err
+	}
+
+	err = os.WriteFile(output, src, 0o644)
+	if err != nil {
+		return fmt.Errorf("could not write %s: %w", output, err)
+	}
+
+	return nil
+}
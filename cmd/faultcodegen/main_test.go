@@ -0,0 +1,90 @@
+package main
+
+import (
+	"go/ast"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestGenerate(t *testing.T) {
+	dir := t.TempDir()
+
+	src := `package codes
+
+// Code is a code.
+type Code int
+
+const (
+	// First is the first code. It comes first.
+	First Code = iota
+
+	Second // level:"warn" http:"409" name:"second"
+
+	Third // the third code
+)
+`
+
+	err := os.WriteFile(filepath.Join(dir, "codes.go"), []byte(src), 0o644)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// A stale generated file must not prevent the generation.
+	err = os.WriteFile(filepath.Join(dir, "code_string.go"), []byte("package codes\n\nvar _ = Missing\n"), 0o644)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = generate(dir, []string{"Code"}, "")
+	if err != nil {
+		t.Fatalf("generate: %v", err)
+	}
+
+	data, err := os.ReadFile(filepath.Join(dir, "code_string.go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	out := string(data)
+
+	for _, want := range []string{
+		`func ParseCode(str string) (Code, error)`,
+		`case "second", "Second":`,
+		`Description: "First is the first code.",`,
+		`Level:       flt.WARNING,`,
+		`HTTPStatus:  409,`,
+		`Description: "the third code",`,
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("output does not contain %q:\n%s", want, out)
+		}
+	}
+}
+
+func TestNewCodeConstInvalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		comment string
+	}{
+		{name: "level", comment: `level:"LOUD"`},
+		{name: "http", comment: `http:"42"`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := newCodeConst("X", 0, nil, commentGroup(tt.comment))
+			if err == nil {
+				t.Errorf("expected an error for %s", tt.comment)
+			}
+		})
+	}
+}
+
+// commentGroup returns a line comment with the given text.
+func commentGroup(text string) *ast.CommentGroup {
+	return &ast.CommentGroup{
+		List: []*ast.Comment{{Text: "// " + text}},
+	}
+}
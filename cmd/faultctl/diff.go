@@ -0,0 +1,118 @@
+package main
+
+import (
+	"cmp"
+	"flag"
+	"fmt"
+	"io"
+	"slices"
+
+	flt "github.com/PlayerR9/go-fault"
+)
+
+// runDiff runs the diff command.
+//
+// The kinds of faults (i.e., fingerprints) of the new log are compared against the ones
+// of the old log. New kinds are prefixed with "+", kinds that disappeared with "-" and,
+// with -all, kinds present in both logs with "=".
+func runDiff(args []string, stdin io.Reader, stdout, stderr io.Writer) flt.Fault {
+	fs := flag.NewFlagSet("diff", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+
+	var ff filterFlags
+	ff.register(fs)
+
+	var all bool
+
+	fs.BoolVar(&all, "all", false, "also list the kinds of faults present in both logs")
+
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "Usage: faultctl diff [flags] <old> <new>")
+		fs.PrintDefaults()
+	}
+
+	err := parse(fs, args)
+	if err != nil {
+		return err
+	}
+
+	if fs.NArg() != 2 {
+		fs.Usage()
+
+		return errUsage
+	}
+
+	if isStdin(fs.Arg(0)) && isStdin(fs.Arg(1)) {
+		fmt.Fprintln(fs.Output(), "faultctl diff: at most one of the logs can be the standard input")
+		fs.Usage()
+
+		return errUsage
+	}
+
+	filter, err := ff.filter()
+	if err != nil {
+		return err
+	}
+
+	old_r, err := open(fs.Arg(0), stdin, filter)
+	if err != nil {
+		return err
+	}
+
+	old_groups := groupEntries(old_r)
+
+	err = old_r.Err()
+	if err != nil {
+		return err
+	}
+
+	new_r, err := open(fs.Arg(1), stdin, filter)
+	if err != nil {
+		return err
+	}
+
+	new_groups := groupEntries(new_r)
+
+	err = new_r.Err()
+	if err != nil {
+		return err
+	}
+
+	type line struct {
+		sign  byte
+		group *group
+		delta string
+	}
+
+	var lines []line
+
+	for fp, g := range new_groups {
+		old, ok := old_groups[fp]
+		if !ok {
+			lines = append(lines, line{sign: '+', group: g, delta: fmt.Sprintf("%d", g.count)})
+		} else if all {
+			lines = append(lines, line{sign: '=', group: g, delta: fmt.Sprintf("%d -> %d", old.count, g.count)})
+		}
+	}
+
+	for fp, g := range old_groups {
+		_, ok := new_groups[fp]
+		if !ok {
+			lines = append(lines, line{sign: '-', group: g, delta: fmt.Sprintf("%d", g.count)})
+		}
+	}
+
+	slices.SortFunc(lines, func(a, b line) int {
+		return cmp.Or(
+			cmp.Compare(a.sign, b.sign),
+			cmp.Compare(b.group.count, a.group.count),
+			cmp.Compare(a.group.fingerprint, b.group.fingerprint),
+		)
+	})
+
+	for _, l := range lines {
+		fmt.Fprintf(stdout, "%c %s [%s] (%s) %s (%s)\n", l.sign, l.group.fingerprint, l.group.level, l.group.code, l.group.template, l.delta)
+	}
+
+	return nil
+}
@@ -0,0 +1,151 @@
+package main
+
+import (
+	"cmp"
+	"flag"
+	"fmt"
+	"io"
+	"slices"
+	"text/tabwriter"
+	"time"
+
+	flt "github.com/PlayerR9/go-fault"
+	"github.com/PlayerR9/go-fault/faults/journal"
+)
+
+// group is the aggregation of the entries of a fingerprint.
+type group struct {
+	// fingerprint is the fingerprint of the entries.
+	fingerprint string
+
+	// level is the level of the entries.
+	level string
+
+	// code is the code of the entries.
+	code string
+
+	// template is the message template of the entries.
+	template string
+
+	// count is the number of occurrences.
+	count int
+
+	// first is the time of the first occurrence.
+	first time.Time
+
+	// last is the time of the last occurrence.
+	last time.Time
+}
+
+// groupEntries aggregates the entries by fingerprint.
+//
+// Parameters:
+//   - r: The reader of the entries.
+//
+// Returns:
+//   - map[string]*group: The groups, by fingerprint.
+func groupEntries(r *journal.Reader) map[string]*group {
+	groups := make(map[string]*group)
+
+	for entry := range r.Entries() {
+		rec := entry.Record
+
+		first, last := rec.Timestamp, rec.Timestamp
+		if entry.Repeated > 0 {
+			first, last = entry.FirstSeen, entry.LastSeen
+		}
+
+		g, ok := groups[rec.Fingerprint]
+		if !ok {
+			template := rec.Template
+			if template == "" {
+				template = rec.Message
+			}
+
+			g = &group{
+				fingerprint: rec.Fingerprint,
+				level:       rec.Level,
+				code:        rec.Code,
+				template:    template,
+				first:       first,
+				last:        last,
+			}
+
+			groups[rec.Fingerprint] = g
+		}
+
+		g.count += entry.Occurrences()
+
+		if first.Before(g.first) {
+			g.first = first
+		}
+
+		if last.After(g.last) {
+			g.last = last
+		}
+	}
+
+	return groups
+}
+
+// runGroup runs the group command. The groups are sorted by decreasing count.
+func runGroup(args []string, stdin io.Reader, stdout, stderr io.Writer) flt.Fault {
+	fs := flag.NewFlagSet("group", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+
+	var ff filterFlags
+	ff.register(fs)
+
+	err := parse(fs, args)
+	if err != nil {
+		return err
+	}
+
+	path, err := singlePath(fs)
+	if err != nil {
+		return err
+	}
+
+	filter, err := ff.filter()
+	if err != nil {
+		return err
+	}
+
+	r, err := open(path, stdin, filter)
+	if err != nil {
+		return err
+	}
+
+	groups := make([]*group, 0)
+
+	for _, g := range groupEntries(r) {
+		groups = append(groups, g)
+	}
+
+	slices.SortFunc(groups, func(a, b *group) int {
+		return cmp.Or(
+			cmp.Compare(b.count, a.count),
+			cmp.Compare(a.fingerprint, b.fingerprint),
+		)
+	})
+
+	tw := tabwriter.NewWriter(stdout, 0, 4, 2, ' ', 0)
+
+	fmt.Fprintln(tw, "COUNT\tFIRST SEEN\tLAST SEEN\tLEVEL\tCODE\tFINGERPRINT\tMESSAGE")
+
+	for _, g := range groups {
+		fmt.Fprintf(tw, "%d\t%s\t%s\t%s\t%s\t%s\t%s\n",
+			g.count,
+			g.first.Format(time.RFC3339),
+			g.last.Format(time.RFC3339),
+			g.level,
+			g.code,
+			g.fingerprint,
+			g.template,
+		)
+	}
+
+	_ = tw.Flush()
+
+	return r.Err()
+}
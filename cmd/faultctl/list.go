@@ -0,0 +1,65 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"time"
+
+	flt "github.com/PlayerR9/go-fault"
+)
+
+// runList runs the list command.
+//
+// Format:
+//
+//	"<timestamp> [<level>] (<code>) <message> <fingerprint>"
+//
+// A " (x<n>)" suffix is added to the summaries of repeated faults.
+func runList(args []string, stdin io.Reader, stdout, stderr io.Writer) flt.Fault {
+	fs := flag.NewFlagSet("list", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+
+	var ff filterFlags
+	ff.register(fs)
+
+	err := parse(fs, args)
+	if err != nil {
+		return err
+	}
+
+	path, err := singlePath(fs)
+	if err != nil {
+		return err
+	}
+
+	filter, err := ff.filter()
+	if err != nil {
+		return err
+	}
+
+	r, err := open(path, stdin, filter)
+	if err != nil {
+		return err
+	}
+
+	for entry := range r.Entries() {
+		rec := entry.Record
+
+		line := fmt.Sprintf("%s [%s] (%s) %s %s",
+			rec.Timestamp.Format(time.RFC3339),
+			rec.Level,
+			rec.Code,
+			rec.Message,
+			rec.Fingerprint,
+		)
+
+		if entry.Repeated > 0 {
+			line += fmt.Sprintf(" (x%d)", entry.Repeated)
+		}
+
+		fmt.Fprintln(stdout, line)
+	}
+
+	return r.Err()
+}
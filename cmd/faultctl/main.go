@@ -0,0 +1,312 @@
+// Command faultctl inspects the JSON-lines fault logs written by the faults package; such
+// as the segments of a faults/journal or the output of a faults.JSONSink.
+//
+// Usage:
+//
+//	faultctl <command> [flags] [file]
+//
+// The commands are:
+//
+//	list   list the faults, one per line
+//	show   render the faults in full
+//	group  aggregate the faults by fingerprint
+//	stats  show histograms of the faults per code and level
+//	diff   compare two logs to spot new kinds of faults
+//
+// The file is the path of the current segment of a journal (its rotated segments are read
+// as well) or any JSON-lines file. If it is omitted or "-", the standard input is read.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	flt "github.com/PlayerR9/go-fault"
+	"github.com/PlayerR9/go-fault/faults"
+	"github.com/PlayerR9/go-fault/faults/journal"
+)
+
+// command is a subcommand of faultctl.
+type command struct {
+	// name is the name of the command.
+	name string
+
+	// summary is the one-line description of the command.
+	summary string
+
+	// run runs the command with the given arguments.
+	run func(args []string, stdin io.Reader, stdout, stderr io.Writer) flt.Fault
+}
+
+// commands are the subcommands of faultctl.
+var commands []command
+
+func init() {
+	commands = []command{
+		{name: "list", summary: "list the faults, one per line", run: runList},
+		{name: "show", summary: "render the faults in full", run: runShow},
+		{name: "group", summary: "aggregate the faults by fingerprint", run: runGroup},
+		{name: "stats", summary: "show histograms of the faults per code and level", run: runStats},
+		{name: "diff", summary: "compare two logs to spot new kinds of faults", run: runDiff},
+	}
+}
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdin, os.Stdout, os.Stderr))
+}
+
+// run runs faultctl.
+//
+// Parameters:
+//   - args: The command-line arguments, without the program name.
+//   - stdin: The standard input.
+//   - stdout: The standard output.
+//   - stderr: The standard error.
+//
+// Returns:
+//   - int: The exit code.
+func run(args []string, stdin io.Reader, stdout, stderr io.Writer) int {
+	if len(args) == 0 || args[0] == "help" || args[0] == "-h" || args[0] == "--help" {
+		usage(stderr)
+
+		return 2
+	}
+
+	for _, cmd := range commands {
+		if cmd.name != args[0] {
+			continue
+		}
+
+		err := cmd.run(args[1:], stdin, stdout, stderr)
+		if err == nil {
+			return 0
+		}
+
+		if err == errUsage {
+			return 2
+		}
+
+		for _, line := range faults.LinesOf(err) {
+			fmt.Fprintln(stderr, line)
+		}
+
+		return 1
+	}
+
+	fmt.Fprintf(stderr, "faultctl: unknown command %q\n\n", args[0])
+	usage(stderr)
+
+	return 2
+}
+
+// usage writes the usage of faultctl.
+//
+// Parameters:
+//   - w: The writer to write to.
+func usage(w io.Writer) {
+	fmt.Fprintln(w, "Usage: faultctl <command> [flags] [file]")
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "Commands:")
+
+	for _, cmd := range commands {
+		fmt.Fprintf(w, "  %-6s %s\n", cmd.name, cmd.summary)
+	}
+
+	fmt.Fprintln(w)
+	fmt.Fprintln(w, "Run 'faultctl <command> -h' for the flags of a command.")
+}
+
+// errUsage is returned by commands whose arguments are invalid; once the usage has been
+// written.
+var errUsage flt.Fault = flt.New(flt.BadParameter, "invalid usage")
+
+// filterFlags are the flags that select the entries.
+type filterFlags struct {
+	// level is the minimum level. Only used if set.
+	level flt.FaultLevel
+
+	// level_set tells whether the level was set.
+	level_set bool
+
+	// codes is the comma-separated list of codes.
+	codes string
+
+	// since is the start of the time range.
+	since string
+
+	// until is the end of the time range.
+	until string
+
+	// query is the fault query. (See faults.ParseQuery.)
+	query string
+}
+
+// register registers the filter flags in the flag set.
+//
+// Parameters:
+//   - fs: The flag set.
+func (ff *filterFlags) register(fs *flag.FlagSet) {
+	ff.level = flt.UnknownLevel
+
+	fs.Func("level", "only the faults at least as severe as `LEVEL` (e.g., WARNING)", func(s string) error {
+		ff.level_set = true
+
+		return flt.LevelFlag(&ff.level).Set(s)
+	})
+
+	fs.StringVar(&ff.codes, "code", "", "only the faults whose code is in the comma-separated `LIST`")
+	fs.StringVar(&ff.since, "since", "", "only the faults that occurred at or after `TIME` (RFC 3339 or a duration ago, e.g., 2h)")
+	fs.StringVar(&ff.until, "until", "", "only the faults that occurred before `TIME` (RFC 3339 or a duration ago, e.g., 30m)")
+	fs.StringVar(&ff.query, "query", "", "only the faults selected by `QUERY` (e.g., 'level>=ERROR and msg~\"timeout\"')")
+}
+
+// filter returns the journal filter described by the flags.
+//
+// Returns:
+//   - journal.Filter: The filter.
+//   - flt.Fault: The fault that occurred while parsing the flags, if any.
+func (ff filterFlags) filter() (journal.Filter, flt.Fault) {
+	var filter journal.Filter
+
+	if ff.level_set {
+		level := ff.level
+		filter.MinLevel = &level
+	}
+
+	if ff.codes != "" {
+		for _, code := range strings.Split(ff.codes, ",") {
+			code = strings.TrimSpace(code)
+			if code != "" {
+				filter.Codes = append(filter.Codes, code)
+			}
+		}
+	}
+
+	var err flt.Fault
+
+	filter.Since, err = parseTime(ff.since, "since")
+	if err != nil {
+		return filter, err
+	}
+
+	filter.Until, err = parseTime(ff.until, "until")
+	if err != nil {
+		return filter, err
+	}
+
+	if ff.query != "" {
+		filter.Query, err = faults.ParseQuery(ff.query)
+		if err != nil {
+			return filter, err
+		}
+	}
+
+	return filter, nil
+}
+
+// parseTime parses either an RFC 3339 time or a duration ago.
+//
+// Parameters:
+//   - str: The string to parse. If empty, the zero time is returned.
+//   - name: The name of the flag; for the fault.
+//
+// Returns:
+//   - time.Time: The parsed time.
+//   - flt.Fault: The fault that occurred while parsing, if any.
+func parseTime(str, name string) (time.Time, flt.Fault) {
+	if str == "" {
+		return time.Time{}, nil
+	}
+
+	t, err := time.Parse(time.RFC3339, str)
+	if err == nil {
+		return t, nil
+	}
+
+	d, err := time.ParseDuration(str)
+	if err == nil {
+		return time.Now().Add(-d), nil
+	}
+
+	return time.Time{}, faults.NewInvalidUsage(
+		fmt.Sprintf("invalid -%s flag (%q)", name, str),
+		"Use an RFC 3339 time such as 2024-10-07T09:47:12Z or a duration such as 2h",
+	)
+}
+
+// parse parses the flags of a command.
+//
+// Parameters:
+//   - fs: The flag set of the command.
+//   - args: The arguments of the command.
+//
+// Returns:
+//   - flt.Fault: errUsage if the arguments are invalid.
+func parse(fs *flag.FlagSet, args []string) flt.Fault {
+	err := fs.Parse(args)
+	if err != nil {
+		return errUsage
+	}
+
+	return nil
+}
+
+// open opens the reader of the log at the given path.
+//
+// Parameters:
+//   - path: The path of the log. If empty or "-", stdin is read.
+//   - stdin: The standard input.
+//   - filter: The filter that selects the entries.
+//
+// Returns:
+//   - *journal.Reader: The reader.
+//   - flt.Fault: The fault that occurred while opening the log, if any.
+func open(path string, stdin io.Reader, filter journal.Filter) (*journal.Reader, flt.Fault) {
+	if isStdin(path) {
+		return journal.NewStreamReader(stdin, filter), nil
+	}
+
+	_, err := os.Stat(path)
+	if err != nil {
+		return nil, faults.FromErrWithMsg(err, fmt.Sprintf("could not open the log (%q)", path))
+	}
+
+	return journal.NewReader(path, filter)
+}
+
+// isStdin tells whether the path of a log stands for the standard input.
+//
+// Parameters:
+//   - path: The path of the log.
+//
+// Returns:
+//   - bool: True if the path is empty or "-", false otherwise.
+func isStdin(path string) bool {
+	return path == "" || path == "-"
+}
+
+// singlePath returns the optional path argument of a command.
+//
+// Parameters:
+//   - fs: The flag set of the command, once parsed.
+//
+// Returns:
+//   - string: The path. Empty if none was given.
+//   - flt.Fault: errUsage if more than one argument was given.
+func singlePath(fs *flag.FlagSet) (string, flt.Fault) {
+	switch fs.NArg() {
+	case 0:
+		return "", nil
+	case 1:
+		return fs.Arg(0), nil
+	default:
+		fmt.Fprintf(fs.Output(), "faultctl %s: too many arguments\n", fs.Name())
+		fs.Usage()
+
+		return "", errUsage
+	}
+}
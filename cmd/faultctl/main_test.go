@@ -0,0 +1,175 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestRun(t *testing.T) {
+	newLog := filepath.Join("testdata", "new.jsonl")
+	oldLog := filepath.Join("testdata", "old.jsonl")
+
+	stdin, err := os.ReadFile(newLog)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name       string
+		args       []string
+		wantCode   int
+		wantStdout []string
+		wantStderr string
+	}{
+		{
+			name:     "list",
+			args:     []string{"list", newLog},
+			wantCode: 0,
+			wantStdout: []string{
+				"2024-10-07T09:00:00Z [ERROR] (BadParameter) port must be positive v2:9f80e7cb21c57ad75452920ee91e68a4",
+				"2024-10-07T10:00:00Z [ERROR] (BadParameter) port must be positive v2:9f80e7cb21c57ad75452920ee91e68a4 (x2)",
+				"2024-10-07T11:00:00Z [ERROR] (NotFound) no such user v2:0357fa4226333ef81e76835b7ace2a1b",
+				"2024-10-07T12:00:00Z [WARNING] (OperationFailed) slow disk v2:670e2286529a5156cbf7f8181b9e8cb7",
+			},
+		},
+		{
+			name:     "list of the standard input",
+			args:     []string{"list", "-code", "NotFound", "-"},
+			wantCode: 0,
+			wantStdout: []string{
+				"2024-10-07T11:00:00Z [ERROR] (NotFound) no such user v2:0357fa4226333ef81e76835b7ace2a1b",
+			},
+		},
+		{
+			name:     "list with a query",
+			args:     []string{"list", "-query", `level<ERROR or msg~"user"`, newLog},
+			wantCode: 0,
+			wantStdout: []string{
+				"2024-10-07T11:00:00Z [ERROR] (NotFound) no such user v2:0357fa4226333ef81e76835b7ace2a1b",
+				"2024-10-07T12:00:00Z [WARNING] (OperationFailed) slow disk v2:670e2286529a5156cbf7f8181b9e8cb7",
+			},
+		},
+		{
+			name:       "list with an invalid query",
+			args:       []string{"list", "-query", "level>=", newLog},
+			wantCode:   1,
+			wantStderr: "invalid query at column",
+		},
+		{
+			name:     "show",
+			args:     []string{"show", "-fingerprint", "v2:9f80", "-n", "1", newLog},
+			wantCode: 0,
+			wantStdout: []string{
+				"[ERROR] (BadParameter) port must be positive.",
+				"",
+				"Occurred at: 2024-10-07 09:00:00 +0000 UTC",
+				"Stack trace:",
+				"-  <- Listen",
+			},
+		},
+		{
+			name:     "group",
+			args:     []string{"group", newLog},
+			wantCode: 0,
+			wantStdout: []string{
+				"COUNT  FIRST SEEN            LAST SEEN             LEVEL    CODE             FINGERPRINT                          MESSAGE",
+				"3      2024-10-07T09:00:00Z  2024-10-07T10:00:00Z  ERROR    BadParameter     v2:9f80e7cb21c57ad75452920ee91e68a4  port must be positive",
+				"1      2024-10-07T11:00:00Z  2024-10-07T11:00:00Z  ERROR    NotFound         v2:0357fa4226333ef81e76835b7ace2a1b  no such user",
+				"1      2024-10-07T12:00:00Z  2024-10-07T12:00:00Z  WARNING  OperationFailed  v2:670e2286529a5156cbf7f8181b9e8cb7  slow disk",
+			},
+		},
+		{
+			name:     "stats",
+			args:     []string{"stats", "-level", "ERROR", newLog},
+			wantCode: 0,
+			wantStdout: []string{
+				"Total: 4",
+				"",
+				"By level:",
+				"  ERROR        4 ########################################",
+				"",
+				"By code:",
+				"  BadParameter        3 ########################################",
+				"  NotFound            1 #############",
+			},
+		},
+		{
+			name:     "diff",
+			args:     []string{"diff", "-all", oldLog, newLog},
+			wantCode: 0,
+			wantStdout: []string{
+				"+ v2:0357fa4226333ef81e76835b7ace2a1b [ERROR] (NotFound) no such user (1)",
+				"+ v2:670e2286529a5156cbf7f8181b9e8cb7 [WARNING] (OperationFailed) slow disk (1)",
+				"- v2:d4e8e36485cf2f0121ac2e5300cb9558 [FATAL] (DataLoss) lost a segment (1)",
+				"= v2:9f80e7cb21c57ad75452920ee91e68a4 [ERROR] (BadParameter) port must be positive (1 -> 3)",
+			},
+		},
+		{
+			name:     "diff with the standard input",
+			args:     []string{"diff", oldLog, "-"},
+			wantCode: 0,
+			wantStdout: []string{
+				"+ v2:0357fa4226333ef81e76835b7ace2a1b [ERROR] (NotFound) no such user (1)",
+				"+ v2:670e2286529a5156cbf7f8181b9e8cb7 [WARNING] (OperationFailed) slow disk (1)",
+				"- v2:d4e8e36485cf2f0121ac2e5300cb9558 [FATAL] (DataLoss) lost a segment (1)",
+			},
+		},
+		{
+			name:       "diff of the standard input twice",
+			args:       []string{"diff", "-", "-"},
+			wantCode:   2,
+			wantStderr: "at most one of the logs can be the standard input",
+		},
+		{
+			name:       "diff of one log",
+			args:       []string{"diff", oldLog},
+			wantCode:   2,
+			wantStderr: "Usage: faultctl diff",
+		},
+		{
+			name:       "too many arguments",
+			args:       []string{"list", oldLog, newLog},
+			wantCode:   2,
+			wantStderr: "faultctl list: too many arguments",
+		},
+		{
+			name:       "missing log",
+			args:       []string{"list", filepath.Join("testdata", "nope.jsonl")},
+			wantCode:   1,
+			wantStderr: "could not open the log",
+		},
+		{
+			name:       "unknown command",
+			args:       []string{"tail"},
+			wantCode:   2,
+			wantStderr: `faultctl: unknown command "tail"`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var stdout, stderr strings.Builder
+
+			code := run(tt.args, strings.NewReader(string(stdin)), &stdout, &stderr)
+
+			if code != tt.wantCode {
+				t.Errorf("got the exit code %d, want %d (stderr: %q)", code, tt.wantCode, stderr.String())
+			}
+
+			var want string
+			if tt.wantStdout != nil {
+				want = strings.Join(tt.wantStdout, "\n") + "\n"
+			}
+
+			if got := stdout.String(); got != want {
+				t.Errorf("got the output:\n%s\nwant:\n%s", got, want)
+			}
+
+			if !strings.Contains(stderr.String(), tt.wantStderr) {
+				t.Errorf("got the errors %q, want them to contain %q", stderr.String(), tt.wantStderr)
+			}
+		})
+	}
+}
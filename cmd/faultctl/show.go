@@ -0,0 +1,75 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io"
+	"strings"
+
+	flt "github.com/PlayerR9/go-fault"
+	"github.com/PlayerR9/go-fault/faults"
+)
+
+// runShow runs the show command. Each selected fault is rendered with faults.LinesOf and
+// the faults are separated by an empty line.
+func runShow(args []string, stdin io.Reader, stdout, stderr io.Writer) flt.Fault {
+	fs := flag.NewFlagSet("show", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+
+	var ff filterFlags
+	ff.register(fs)
+
+	var fingerprint string
+	var limit int
+
+	fs.StringVar(&fingerprint, "fingerprint", "", "only the faults whose fingerprint starts with `PREFIX`")
+	fs.IntVar(&limit, "n", 0, "show at most `N` faults (0 for all)")
+
+	err := parse(fs, args)
+	if err != nil {
+		return err
+	}
+
+	path, err := singlePath(fs)
+	if err != nil {
+		return err
+	}
+
+	filter, err := ff.filter()
+	if err != nil {
+		return err
+	}
+
+	r, err := open(path, stdin, filter)
+	if err != nil {
+		return err
+	}
+
+	var count int
+
+	for entry := range r.Entries() {
+		if !strings.HasPrefix(entry.Record.Fingerprint, fingerprint) {
+			continue
+		}
+
+		if count > 0 {
+			fmt.Fprintln(stdout)
+		}
+
+		for _, line := range faults.LinesOf(entry.Record.Fault()) {
+			fmt.Fprintln(stdout, line)
+		}
+
+		if entry.Repeated > 0 {
+			fmt.Fprintf(stdout, "Repeated %d times between %s and %s.\n", entry.Repeated, entry.FirstSeen, entry.LastSeen)
+		}
+
+		count++
+
+		if limit > 0 && count == limit {
+			break
+		}
+	}
+
+	return r.Err()
+}
@@ -0,0 +1,114 @@
+package main
+
+import (
+	"cmp"
+	"flag"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+
+	flt "github.com/PlayerR9/go-fault"
+)
+
+const (
+	// barWidth is the width of the longest bar of a histogram.
+	barWidth int = 40
+)
+
+// runStats runs the stats command.
+func runStats(args []string, stdin io.Reader, stdout, stderr io.Writer) flt.Fault {
+	fs := flag.NewFlagSet("stats", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+
+	var ff filterFlags
+	ff.register(fs)
+
+	err := parse(fs, args)
+	if err != nil {
+		return err
+	}
+
+	path, err := singlePath(fs)
+	if err != nil {
+		return err
+	}
+
+	filter, err := ff.filter()
+	if err != nil {
+		return err
+	}
+
+	r, err := open(path, stdin, filter)
+	if err != nil {
+		return err
+	}
+
+	by_code := make(map[string]int)
+	by_level := make(map[string]int)
+
+	var total int
+
+	for entry := range r.Entries() {
+		n := entry.Occurrences()
+
+		by_code[entry.Record.Code] += n
+		by_level[entry.Record.Level] += n
+		total += n
+	}
+
+	fmt.Fprintf(stdout, "Total: %d\n", total)
+
+	fmt.Fprintln(stdout)
+	fmt.Fprintln(stdout, "By level:")
+	histogram(stdout, by_level, func(a, b string) int {
+		la, _ := flt.ParseLevel(a)
+		lb, _ := flt.ParseLevel(b)
+
+		return cmp.Or(lb.Compare(la), cmp.Compare(a, b))
+	})
+
+	fmt.Fprintln(stdout)
+	fmt.Fprintln(stdout, "By code:")
+	histogram(stdout, by_code, nil)
+
+	return r.Err()
+}
+
+// histogram writes a histogram of the counts.
+//
+// Parameters:
+//   - w: The writer to write to.
+//   - counts: The counts, by label.
+//   - order: The order of the labels. If nil, the labels are sorted by decreasing count.
+func histogram(w io.Writer, counts map[string]int, order func(a, b string) int) {
+	labels := make([]string, 0, len(counts))
+
+	var width, highest int
+
+	for label, count := range counts {
+		labels = append(labels, label)
+
+		width = max(width, len(label))
+		highest = max(highest, count)
+	}
+
+	if order == nil {
+		order = func(a, b string) int {
+			return cmp.Or(cmp.Compare(counts[b], counts[a]), cmp.Compare(a, b))
+		}
+	}
+
+	slices.SortFunc(labels, order)
+
+	for _, label := range labels {
+		count := counts[label]
+
+		bar := count * barWidth / highest
+		if bar == 0 && count > 0 {
+			bar = 1
+		}
+
+		fmt.Fprintf(w, "  %-*s %8d %s\n", width, label, count, strings.Repeat("#", bar))
+	}
+}
@@ -0,0 +1,535 @@
+package main
+
+import (
+	"fmt"
+	"go/ast"
+	"go/constant"
+	"go/token"
+	"go/types"
+	"strings"
+)
+
+const (
+	// faultPath is the import path of the fault package.
+	faultPath string = "github.com/PlayerR9/go-fault"
+
+	// faultsPath is the import path of the faults package.
+	faultsPath string = faultPath + "/faults"
+)
+
+// diagnostic is a problem found by a check.
+type diagnostic struct {
+	// pos is the position of the problem.
+	pos token.Position
+
+	// check is the name of the check that found the problem.
+	check string
+
+	// msg describes the problem.
+	msg string
+}
+
+// String implements the fmt.Stringer interface.
+//
+// Format:
+//
+//	"<file>:<line>:<column>: <message> (<check>)"
+func (d diagnostic) String() string {
+	return fmt.Sprintf("%s: %s (%s)", d.pos, d.msg, d.check)
+}
+
+// pass is a run of a check over a package.
+type pass struct {
+	// fset is the file set of the package.
+	fset *token.FileSet
+
+	// pkg is the package being checked.
+	pkg *pkg
+
+	// check is the name of the running check.
+	check string
+
+	// fault is the fault.Fault interface. Nil if the package does not depend on it.
+	fault *types.Interface
+
+	// diagnostics are the problems found so far.
+	diagnostics *[]diagnostic
+}
+
+// report reports a problem.
+//
+// Parameters:
+//   - pos: The position of the problem.
+//   - format: The format of the message.
+//   - args: The arguments of the format.
+func (p *pass) report(pos token.Pos, format string, args ...any) {
+	*p.diagnostics = append(*p.diagnostics, diagnostic{
+		pos:   p.fset.Position(pos),
+		check: p.check,
+		msg:   fmt.Sprintf(format, args...),
+	})
+}
+
+// check is a check run by faultvet.
+type check struct {
+	// name is the name of the check.
+	name string
+
+	// doc is the one-line description of the check.
+	doc string
+
+	// run runs the check.
+	run func(p *pass)
+}
+
+// checks are the checks run by faultvet.
+var checks []check
+
+func init() {
+	checks = []check{
+		{name: "infolines", doc: "InfoLines methods must not call the InfoLines of the embedded fault", run: checkInfoLines},
+		{name: "embeds", doc: "types that embed a fault must declare an Embeds method", run: checkEmbeds},
+		{name: "discard", doc: "fault results must not be discarded implicitly", run: checkDiscard},
+		{name: "boolresult", doc: "the results of SetSuggestions and AddKey must not be ignored", run: checkBoolResult},
+		{name: "throwframe", doc: "the frames given to Throw must name the enclosing function", run: checkThrowFrame},
+	}
+}
+
+// faultInterface finds the fault.Fault interface among the package and its dependencies.
+//
+// Parameters:
+//   - pkg: The package.
+//
+// Returns:
+//   - *types.Interface: The interface. Nil if the package does not depend on it.
+func faultInterface(pkg *types.Package) *types.Interface {
+	if pkg == nil {
+		return nil
+	}
+
+	seen := make(map[*types.Package]struct{})
+
+	stack := []*types.Package{pkg}
+
+	for len(stack) > 0 {
+		top := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+
+		_, ok := seen[top]
+		if ok {
+			continue
+		}
+
+		seen[top] = struct{}{}
+
+		if top.Path() == faultPath {
+			obj, ok := top.Scope().Lookup("Fault").(*types.TypeName)
+			if !ok {
+				return nil
+			}
+
+			iface, _ := obj.Type().Underlying().(*types.Interface)
+
+			return iface
+		}
+
+		stack = append(stack, top.Imports()...)
+	}
+
+	return nil
+}
+
+// isFault checks whether the type is the fault.Fault interface itself.
+//
+// Parameters:
+//   - typ: The type to check.
+//
+// Returns:
+//   - bool: True if the type is fault.Fault, false otherwise.
+func isFault(typ types.Type) bool {
+	named, ok := typ.(*types.Named)
+	if !ok {
+		return false
+	}
+
+	obj := named.Obj()
+
+	return obj.Name() == "Fault" && obj.Pkg() != nil && obj.Pkg().Path() == faultPath
+}
+
+// isFaultLike checks whether the type is, or implements, the fault.Fault interface.
+//
+// Parameters:
+//   - typ: The type to check.
+//
+// Returns:
+//   - bool: True if the type is fault-like, false otherwise.
+func (p *pass) isFaultLike(typ types.Type) bool {
+	if isFault(typ) {
+		return true
+	}
+
+	if p.fault == nil || typ == nil {
+		return false
+	}
+
+	if types.Implements(typ, p.fault) {
+		return true
+	}
+
+	_, is_ptr := typ.(*types.Pointer)
+	if is_ptr {
+		return false
+	}
+
+	return types.Implements(types.NewPointer(typ), p.fault)
+}
+
+// embeddedFault returns the first field of the struct that embeds a fault.
+//
+// Parameters:
+//   - st: The struct.
+//
+// Returns:
+//   - *types.Var: The field. Nil if the struct embeds no fault.
+func (p *pass) embeddedFault(st *types.Struct) *types.Var {
+	for i := range st.NumFields() {
+		field := st.Field(i)
+
+		if field.Embedded() && p.isFaultLike(field.Type()) {
+			return field
+		}
+	}
+
+	return nil
+}
+
+// funcOf returns the function or method called by the call expression.
+//
+// Parameters:
+//   - call: The call expression.
+//
+// Returns:
+//   - *types.Func: The called function. Nil if it is not a function or a method. (e.g.,
+//     a conversion or a function value)
+func (p *pass) funcOf(call *ast.CallExpr) *types.Func {
+	fun := ast.Unparen(call.Fun)
+
+	index, ok := fun.(*ast.IndexExpr)
+	if ok {
+		fun = index.X
+	}
+
+	var ident *ast.Ident
+
+	switch fun := fun.(type) {
+	case *ast.Ident:
+		ident = fun
+	case *ast.SelectorExpr:
+		ident = fun.Sel
+	default:
+		return nil
+	}
+
+	fn, _ := p.pkg.info.Uses[ident].(*types.Func)
+
+	return fn
+}
+
+// isFunc checks whether the function is one of the functions of the package.
+//
+// Parameters:
+//   - fn: The function.
+//   - path: The import path of the package.
+//   - names: The names of the functions.
+//
+// Returns:
+//   - bool: True if the function is one of them, false otherwise.
+func isFunc(fn *types.Func, path string, names ...string) bool {
+	if fn == nil || fn.Pkg() == nil || fn.Pkg().Path() != path {
+		return false
+	}
+
+	sig, ok := fn.Type().(*types.Signature)
+	if !ok || sig.Recv() != nil {
+		return false
+	}
+
+	for _, name := range names {
+		if fn.Name() == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+// checkInfoLines reports the InfoLines methods of the types that embed a fault and that
+// call the InfoLines of the embedded fault. The embedded fault renders its own lines as
+// part of the embedding tower; calling it renders them twice.
+//
+// Parameters:
+//   - p: The pass.
+func checkInfoLines(p *pass) {
+	for _, file := range p.pkg.files {
+		for _, decl := range file.Decls {
+			fd, ok := decl.(*ast.FuncDecl)
+			if !ok || fd.Recv == nil || fd.Body == nil {
+				continue
+			}
+
+			if fd.Name.Name != "InfoLines" && fd.Name.Name != "InfoLinesIn" {
+				continue
+			}
+
+			obj, ok := p.pkg.info.Defs[fd.Name].(*types.Func)
+			if !ok {
+				continue
+			}
+
+			recv := obj.Type().(*types.Signature).Recv().Type()
+
+			ptr, ok := recv.(*types.Pointer)
+			if ok {
+				recv = ptr.Elem()
+			}
+
+			st, ok := recv.Underlying().(*types.Struct)
+			if !ok || p.embeddedFault(st) == nil {
+				continue
+			}
+
+			ast.Inspect(fd.Body, func(n ast.Node) bool {
+				call, ok := n.(*ast.CallExpr)
+				if !ok {
+					return true
+				}
+
+				sel, ok := ast.Unparen(call.Fun).(*ast.SelectorExpr)
+				if !ok || (sel.Sel.Name != "InfoLines" && sel.Sel.Name != "InfoLinesIn") {
+					return true
+				}
+
+				if p.viaEmbedded(sel) {
+					p.report(call.Pos(), "%s calls the %s of the embedded fault; its lines are already rendered by the embedding tower", fd.Name.Name, sel.Sel.Name)
+				}
+
+				return true
+			})
+		}
+	}
+}
+
+// viaEmbedded checks whether the selector selects a method through an embedded field;
+// either explicitly (e.g., e.Fault.InfoLines) or by promotion.
+//
+// Parameters:
+//   - sel: The selector of the method.
+//
+// Returns:
+//   - bool: True if the method is selected through an embedded field, false otherwise.
+func (p *pass) viaEmbedded(sel *ast.SelectorExpr) bool {
+	selection, ok := p.pkg.info.Selections[sel]
+	if !ok {
+		return false
+	}
+
+	if len(selection.Index()) > 1 {
+		return true
+	}
+
+	x, ok := ast.Unparen(sel.X).(*ast.SelectorExpr)
+	if !ok {
+		return false
+	}
+
+	field, ok := p.pkg.info.Selections[x]
+	if !ok || field.Kind() != types.FieldVal {
+		return false
+	}
+
+	v, ok := field.Obj().(*types.Var)
+
+	return ok && v.Embedded()
+}
+
+// checkEmbeds reports the struct types that embed a fault without declaring an Embeds
+// method. The promoted Embeds method returns the base of the embedded fault, which skips
+// a level of the embedding tower.
+//
+// Parameters:
+//   - p: The pass.
+func checkEmbeds(p *pass) {
+	for _, file := range p.pkg.files {
+		ast.Inspect(file, func(n ast.Node) bool {
+			spec, ok := n.(*ast.TypeSpec)
+			if !ok {
+				return true
+			}
+
+			obj, ok := p.pkg.info.Defs[spec.Name].(*types.TypeName)
+			if !ok {
+				return true
+			}
+
+			st, ok := obj.Type().Underlying().(*types.Struct)
+			if !ok {
+				return true
+			}
+
+			field := p.embeddedFault(st)
+			if field == nil {
+				return true
+			}
+
+			method, index, _ := types.LookupFieldOrMethod(obj.Type(), true, obj.Pkg(), "Embeds")
+			if method == nil || len(index) > 1 {
+				p.report(spec.Name.Pos(), "%s embeds the fault %s but does not declare an Embeds method", spec.Name.Name, field.Name())
+			}
+
+			return true
+		})
+	}
+}
+
+// checkDiscard reports the calls, used as statements, whose results include a fault.
+// Faults that are deliberately ignored must be assigned to the blank identifier.
+//
+// Parameters:
+//   - p: The pass.
+func checkDiscard(p *pass) {
+	for _, file := range p.pkg.files {
+		ast.Inspect(file, func(n ast.Node) bool {
+			stmt, ok := n.(*ast.ExprStmt)
+			if !ok {
+				return true
+			}
+
+			call, ok := ast.Unparen(stmt.X).(*ast.CallExpr)
+			if !ok {
+				return true
+			}
+
+			tv, ok := p.pkg.info.Types[call]
+			if !ok || tv.IsType() {
+				return true
+			}
+
+			var found bool
+
+			switch typ := tv.Type.(type) {
+			case *types.Tuple:
+				for i := range typ.Len() {
+					if isFault(typ.At(i).Type()) {
+						found = true
+
+						break
+					}
+				}
+			default:
+				found = isFault(typ)
+			}
+
+			if found {
+				p.report(call.Pos(), "the fault returned by %s is discarded; handle it or assign it to _", types.ExprString(call.Fun))
+			}
+
+			return true
+		})
+	}
+}
+
+// checkBoolResult reports the calls to faults.SetSuggestions and faults.AddKey whose
+// result, which tells whether the fault was modified, is ignored implicitly.
+//
+// Parameters:
+//   - p: The pass.
+func checkBoolResult(p *pass) {
+	for _, file := range p.pkg.files {
+		ast.Inspect(file, func(n ast.Node) bool {
+			stmt, ok := n.(*ast.ExprStmt)
+			if !ok {
+				return true
+			}
+
+			call, ok := ast.Unparen(stmt.X).(*ast.CallExpr)
+			if !ok {
+				return true
+			}
+
+			fn := p.funcOf(call)
+			if isFunc(fn, faultsPath, "SetSuggestions", "AddKey") {
+				p.report(call.Pos(), "the result of %s is ignored; check it or assign it to _", fn.Name())
+			}
+
+			return true
+		})
+	}
+}
+
+// checkThrowFrame reports the calls to faults.Throw whose frame is a constant that does
+// not name the enclosing function. Frames such as "Get", "Get()", "Set.Get" and
+// "(*Set).Get" all name the function (or method) Get. Function literals are part of the
+// enclosing function.
+//
+// Parameters:
+//   - p: The pass.
+func checkThrowFrame(p *pass) {
+	for _, file := range p.pkg.files {
+		for _, decl := range file.Decls {
+			fd, ok := decl.(*ast.FuncDecl)
+			if !ok || fd.Body == nil {
+				continue
+			}
+
+			ast.Inspect(fd.Body, func(n ast.Node) bool {
+				call, ok := n.(*ast.CallExpr)
+				if !ok || len(call.Args) != 2 {
+					return true
+				}
+
+				if !isFunc(p.funcOf(call), faultsPath, "Throw") {
+					return true
+				}
+
+				tv, ok := p.pkg.info.Types[call.Args[1]]
+				if !ok || tv.Value == nil || tv.Value.Kind() != constant.String {
+					return true
+				}
+
+				frame := constant.StringVal(tv.Value)
+
+				if frameName(frame) != fd.Name.Name {
+					p.report(call.Args[1].Pos(), "the frame %q does not match the enclosing function %s", frame, fd.Name.Name)
+				}
+
+				return true
+			})
+		}
+	}
+}
+
+// frameName returns the name of the function a frame refers to.
+//
+// Parameters:
+//   - frame: The frame. (e.g., "(*Set).Get(key)")
+//
+// Returns:
+//   - string: The name of the function. (e.g., "Get")
+func frameName(frame string) string {
+	frame = strings.TrimSpace(frame)
+
+	if strings.HasSuffix(frame, ")") {
+		open := strings.LastIndexByte(frame, '(')
+		if open > 0 {
+			frame = frame[:open]
+		}
+	}
+
+	idx := strings.LastIndexAny(frame, "./")
+	if idx >= 0 {
+		frame = frame[idx+1:]
+	}
+
+	return frame
+}
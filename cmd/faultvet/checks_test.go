@@ -0,0 +1,180 @@
+package main
+
+import (
+	"go/token"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// wantPattern matches the "// want `<regexp>`" comments of the test data.
+var wantPattern = regexp.MustCompile("^// want `(.*)`$")
+
+func TestChecks(t *testing.T) {
+	for _, c := range checks {
+		t.Run(c.name, func(t *testing.T) {
+			fset := token.NewFileSet()
+
+			p, fault := load(fset, filepath.Join("testdata", c.name))
+			if fault != nil {
+				t.Fatalf("could not load the test data: %v", fault)
+			}
+
+			if p == nil {
+				t.Fatalf("no test data for the check %q", c.name)
+			}
+
+			for _, err := range p.type_errors {
+				t.Errorf("type error in the test data: %v", err)
+			}
+
+			// The wants are keyed by "<file>:<line>".
+			wants := make(map[string]*regexp.Regexp)
+
+			for _, file := range p.files {
+				for _, group := range file.Comments {
+					for _, comment := range group.List {
+						match := wantPattern.FindStringSubmatch(comment.Text)
+						if match == nil {
+							continue
+						}
+
+						pos := fset.Position(comment.Pos())
+						wants[pos.Filename+":"+strconv.Itoa(pos.Line)] = regexp.MustCompile(match[1])
+					}
+				}
+			}
+
+			var diagnostics []diagnostic
+
+			c.run(&pass{
+				fset:        fset,
+				pkg:         p,
+				check:       c.name,
+				fault:       faultInterface(p.types),
+				diagnostics: &diagnostics,
+			})
+
+			for _, d := range diagnostics {
+				key := d.pos.Filename + ":" + strconv.Itoa(d.pos.Line)
+
+				want, ok := wants[key]
+				if !ok {
+					t.Errorf("unexpected diagnostic: %v", d)
+					continue
+				}
+
+				if !want.MatchString(d.msg) {
+					t.Errorf("%s: got %q, want a match of %q", key, d.msg, want)
+				}
+
+				delete(wants, key)
+			}
+
+			for key, want := range wants {
+				t.Errorf("%s: no diagnostic, want a match of %q", key, want)
+			}
+		})
+	}
+}
+
+func TestSelectChecks(t *testing.T) {
+	tests := []struct {
+		name    string
+		list    string
+		want    []string
+		wantErr bool
+	}{
+		{name: "all", list: "", want: checkNames(checks)},
+		{name: "some", list: "discard, throwframe", want: []string{"discard", "throwframe"}},
+		{name: "empty names", list: "embeds,,", want: []string{"embeds"}},
+		{name: "unknown", list: "discard,nope", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, fault := selectChecks(tt.list)
+
+			if (fault != nil) != tt.wantErr {
+				t.Fatalf("got the fault %v, want a fault = %t", fault, tt.wantErr)
+			}
+
+			if names := checkNames(got); strings.Join(names, ",") != strings.Join(tt.want, ",") {
+				t.Errorf("got %q, want %q", names, tt.want)
+			}
+		})
+	}
+}
+
+func TestRun(t *testing.T) {
+	tests := []struct {
+		name       string
+		args       []string
+		wantCode   int
+		wantStdout string
+		wantStderr string
+	}{
+		{
+			name:       "list",
+			args:       []string{"-list"},
+			wantCode:   0,
+			wantStdout: "throwframe  the frames given to Throw must name the enclosing function",
+		},
+		{
+			name:       "unknown check",
+			args:       []string{"-checks", "nope"},
+			wantCode:   2,
+			wantStderr: `unknown check ("nope")`,
+		},
+		{
+			name:       "problems",
+			args:       []string{"-checks", "discard", filepath.Join("testdata", "discard")},
+			wantCode:   1,
+			wantStdout: "is discarded; handle it or assign it to _ (discard)",
+		},
+		{
+			name:     "no problems",
+			args:     []string{"-checks", "discard", filepath.Join("testdata", "embeds")},
+			wantCode: 0,
+		},
+		{
+			name:       "missing directory",
+			args:       []string{filepath.Join("testdata", "nope")},
+			wantCode:   2,
+			wantStderr: "could not load",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var stdout, stderr strings.Builder
+
+			code := run(tt.args, &stdout, &stderr)
+
+			if code != tt.wantCode {
+				t.Errorf("got the exit code %d, want %d (stderr: %q)", code, tt.wantCode, stderr.String())
+			}
+
+			if !strings.Contains(stdout.String(), tt.wantStdout) {
+				t.Errorf("got the output %q, want it to contain %q", stdout.String(), tt.wantStdout)
+			}
+
+			if !strings.Contains(stderr.String(), tt.wantStderr) {
+				t.Errorf("got the errors %q, want them to contain %q", stderr.String(), tt.wantStderr)
+			}
+		})
+	}
+}
+
+// checkNames returns the names of the checks.
+func checkNames(list []check) []string {
+	names := make([]string, 0, len(list))
+
+	for _, c := range list {
+		names = append(names, c.name)
+	}
+
+	return names
+}
@@ -0,0 +1,219 @@
+package main
+
+import (
+	"bufio"
+	"errors"
+	"go/ast"
+	"go/build"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+
+	flt "github.com/PlayerR9/go-fault"
+	"github.com/PlayerR9/go-fault/faults"
+)
+
+// pkg is a parsed and type-checked package.
+type pkg struct {
+	// dir is the directory of the package.
+	dir string
+
+	// files are the parsed files of the package.
+	files []*ast.File
+
+	// types is the type-checked package.
+	types *types.Package
+
+	// info is the type information of the package.
+	info *types.Info
+
+	// type_errors are the errors found while type-checking the package. The checks still
+	// run on the partial information.
+	type_errors []error
+}
+
+// expand expands the patterns into the directories of the packages. A pattern is either a
+// directory or a directory followed by "/..." to include all the directories below it.
+// As with the go command, the directories whose name starts with "." or "_", and the
+// "testdata" directories, are skipped.
+//
+// Parameters:
+//   - patterns: The patterns to expand. If empty, "." is used.
+//
+// Returns:
+//   - []string: The directories, in order and without duplicates.
+//   - flt.Fault: The fault that occurred while walking the directories, if any.
+func expand(patterns []string) ([]string, flt.Fault) {
+	if len(patterns) == 0 {
+		patterns = []string{"."}
+	}
+
+	var dirs []string
+
+	seen := make(map[string]struct{})
+
+	add := func(dir string) {
+		_, ok := seen[dir]
+		if ok {
+			return
+		}
+
+		seen[dir] = struct{}{}
+		dirs = append(dirs, dir)
+	}
+
+	for _, pattern := range patterns {
+		root, ok := strings.CutSuffix(pattern, "...")
+		if !ok {
+			add(filepath.Clean(pattern))
+
+			continue
+		}
+
+		root = filepath.Clean(strings.TrimSuffix(root, "/"))
+
+		err := filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+
+			if !d.IsDir() {
+				return nil
+			}
+
+			name := d.Name()
+			if path != root && (strings.HasPrefix(name, ".") || strings.HasPrefix(name, "_") || name == "testdata") {
+				return filepath.SkipDir
+			}
+
+			add(path)
+
+			return nil
+		})
+		if err != nil {
+			return nil, faults.FromErrWithMsg(err, "could not walk "+root)
+		}
+	}
+
+	return dirs, nil
+}
+
+// importPath returns the import path of the directory; from the nearest go.mod file.
+//
+// Parameters:
+//   - dir: The directory.
+//
+// Returns:
+//   - string: The import path. The directory itself if no go.mod file is found.
+func importPath(dir string) string {
+	abs, err := filepath.Abs(dir)
+	if err != nil {
+		return dir
+	}
+
+	for root := abs; ; {
+		module := modulePath(filepath.Join(root, "go.mod"))
+		if module != "" {
+			rel, err := filepath.Rel(root, abs)
+			if err != nil || rel == "." {
+				return module
+			}
+
+			return module + "/" + filepath.ToSlash(rel)
+		}
+
+		parent := filepath.Dir(root)
+		if parent == root {
+			return dir
+		}
+
+		root = parent
+	}
+}
+
+// modulePath returns the module path declared in the go.mod file.
+//
+// Parameters:
+//   - path: The path of the go.mod file.
+//
+// Returns:
+//   - string: The module path. Empty if the file does not exist or declares no module.
+func modulePath(path string) string {
+	file, err := os.Open(path)
+	if err != nil {
+		return ""
+	}
+
+	defer file.Close()
+
+	scanner := bufio.NewScanner(file)
+
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+
+		module, ok := strings.CutPrefix(line, "module ")
+		if ok {
+			return strings.Trim(strings.TrimSpace(module), `"`)
+		}
+	}
+
+	return ""
+}
+
+// load parses and type-checks the package in the directory. The test files are not
+// loaded.
+//
+// Parameters:
+//   - fset: The file set to record the positions in.
+//   - dir: The directory of the package.
+//
+// Returns:
+//   - *pkg: The loaded package. Nil if the directory has no Go files.
+//   - flt.Fault: The fault that occurred while loading the package, if any.
+func load(fset *token.FileSet, dir string) (*pkg, flt.Fault) {
+	bp, err := build.ImportDir(dir, 0)
+	if err != nil {
+		var no_go *build.NoGoError
+
+		if errors.As(err, &no_go) {
+			return nil, nil
+		}
+
+		return nil, faults.FromErrWithMsg(err, "could not load "+dir)
+	}
+
+	p := &pkg{
+		dir: dir,
+		info: &types.Info{
+			Types:      make(map[ast.Expr]types.TypeAndValue),
+			Defs:       make(map[*ast.Ident]types.Object),
+			Uses:       make(map[*ast.Ident]types.Object),
+			Selections: make(map[*ast.SelectorExpr]*types.Selection),
+		},
+	}
+
+	for _, name := range bp.GoFiles {
+		file, err := parser.ParseFile(fset, filepath.Join(dir, name), nil, parser.ParseComments)
+		if err != nil {
+			return nil, faults.FromErrWithMsg(err, "could not parse "+filepath.Join(dir, name))
+		}
+
+		p.files = append(p.files, file)
+	}
+
+	conf := types.Config{
+		Importer: importer.ForCompiler(fset, "source", nil),
+		Error: func(err error) {
+			p.type_errors = append(p.type_errors, err)
+		},
+	}
+
+	p.types, _ = conf.Check(importPath(dir), fset, p.files, p.info)
+
+	return p, nil
+}
@@ -0,0 +1,203 @@
+// Command faultvet reports the misuses of the fault and faults packages; much like
+// go vet does for the standard library.
+//
+// Usage:
+//
+//	faultvet [flags] [packages]
+//
+// The packages are directories, optionally followed by "/..." to include all the
+// directories below them. (e.g., "./...") If omitted, the current directory is checked.
+// The test files are not checked.
+//
+// The checks are:
+//
+//	infolines   InfoLines methods must not call the InfoLines of the embedded fault
+//	embeds      types that embed a fault must declare an Embeds method
+//	discard     fault results must not be discarded implicitly
+//	boolresult  the results of SetSuggestions and AddKey must not be ignored
+//	throwframe  the frames given to Throw must name the enclosing function
+//
+// The exit code is 1 if any problem is reported, 2 if the packages could not be loaded
+// and 0 otherwise.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"go/token"
+	"io"
+	"os"
+	"slices"
+	"strings"
+
+	flt "github.com/PlayerR9/go-fault"
+	"github.com/PlayerR9/go-fault/faults"
+)
+
+func main() {
+	os.Exit(run(os.Args[1:], os.Stdout, os.Stderr))
+}
+
+// run runs faultvet.
+//
+// Parameters:
+//   - args: The command-line arguments, without the program name.
+//   - stdout: The standard output.
+//   - stderr: The standard error.
+//
+// Returns:
+//   - int: The exit code.
+func run(args []string, stdout, stderr io.Writer) int {
+	fs := flag.NewFlagSet("faultvet", flag.ContinueOnError)
+	fs.SetOutput(stderr)
+
+	only := fs.String("checks", "", "run only the checks in the comma-separated `LIST`")
+	list := fs.Bool("list", false, "list the checks and exit")
+	verbose := fs.Bool("v", false, "also report the type errors of the packages")
+
+	fs.Usage = func() {
+		fmt.Fprintln(stderr, "Usage: faultvet [flags] [packages]")
+		fmt.Fprintln(stderr)
+		fs.PrintDefaults()
+	}
+
+	err := fs.Parse(args)
+	if err != nil {
+		return 2
+	}
+
+	if *list {
+		for _, c := range checks {
+			fmt.Fprintf(stdout, "%-10s  %s\n", c.name, c.doc)
+		}
+
+		return 0
+	}
+
+	selected, fault := selectChecks(*only)
+	if fault != nil {
+		for _, line := range faults.LinesOf(fault) {
+			fmt.Fprintln(stderr, line)
+		}
+
+		return 2
+	}
+
+	diagnostics, fault := vet(fs.Args(), selected, stderr, *verbose)
+	if fault != nil {
+		for _, line := range faults.LinesOf(fault) {
+			fmt.Fprintln(stderr, line)
+		}
+
+		return 2
+	}
+
+	for _, d := range diagnostics {
+		fmt.Fprintln(stdout, d)
+	}
+
+	if len(diagnostics) > 0 {
+		return 1
+	}
+
+	return 0
+}
+
+// selectChecks returns the checks named in the list.
+//
+// Parameters:
+//   - list: The comma-separated list of check names. If empty, all the checks are
+//     selected.
+//
+// Returns:
+//   - []check: The selected checks.
+//   - flt.Fault: The fault that occurred if a name is unknown.
+func selectChecks(list string) ([]check, flt.Fault) {
+	if list == "" {
+		return checks, nil
+	}
+
+	var selected []check
+
+	for _, name := range strings.Split(list, ",") {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+
+		idx := slices.IndexFunc(checks, func(c check) bool { return c.name == name })
+		if idx < 0 {
+			return nil, faults.NewInvalidUsage(
+				fmt.Sprintf("unknown check (%q)", name),
+				"Run 'faultvet -list' for the available checks",
+			)
+		}
+
+		selected = append(selected, checks[idx])
+	}
+
+	return selected, nil
+}
+
+// vet loads the packages and runs the checks on them.
+//
+// Parameters:
+//   - patterns: The patterns of the packages. (See expand.)
+//   - selected: The checks to run.
+//   - stderr: The writer of the type errors.
+//   - verbose: Whether the type errors are reported.
+//
+// Returns:
+//   - []diagnostic: The problems found, sorted by position.
+//   - flt.Fault: The fault that occurred while loading the packages, if any.
+func vet(patterns []string, selected []check, stderr io.Writer, verbose bool) ([]diagnostic, flt.Fault) {
+	dirs, fault := expand(patterns)
+	if fault != nil {
+		return nil, fault
+	}
+
+	fset := token.NewFileSet()
+
+	var diagnostics []diagnostic
+
+	for _, dir := range dirs {
+		p, fault := load(fset, dir)
+		if fault != nil {
+			return nil, fault
+		}
+
+		if p == nil {
+			continue
+		}
+
+		if verbose {
+			for _, err := range p.type_errors {
+				fmt.Fprintf(stderr, "faultvet: %v\n", err)
+			}
+		}
+
+		for _, c := range selected {
+			c.run(&pass{
+				fset:        fset,
+				pkg:         p,
+				check:       c.name,
+				fault:       faultInterface(p.types),
+				diagnostics: &diagnostics,
+			})
+		}
+	}
+
+	slices.SortStableFunc(diagnostics, func(a, b diagnostic) int {
+		if a.pos.Filename != b.pos.Filename {
+			return strings.Compare(a.pos.Filename, b.pos.Filename)
+		}
+
+		if a.pos.Line != b.pos.Line {
+			return a.pos.Line - b.pos.Line
+		}
+
+		return a.pos.Column - b.pos.Column
+	})
+
+	return diagnostics, nil
+}
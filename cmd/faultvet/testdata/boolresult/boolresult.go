@@ -0,0 +1,19 @@
+package boolresult
+
+import "github.com/PlayerR9/go-fault/faults"
+
+func use() bool {
+	fault := faults.NewBadParameter("x")
+
+	faults.AddKey(fault, "key", 1)            // want `the result of AddKey is ignored`
+	faults.SetSuggestions(fault, "Try again") // want `the result of SetSuggestions is ignored`
+
+	_ = faults.AddKey(fault, "key", 1)
+	_ = faults.SetSuggestions(fault, "Try again")
+
+	if !faults.AddKey(fault, "key", 1) {
+		return false
+	}
+
+	return faults.SetSuggestions(fault, "Try again")
+}
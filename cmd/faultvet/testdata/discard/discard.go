@@ -0,0 +1,39 @@
+package discard
+
+import (
+	"errors"
+
+	flt "github.com/PlayerR9/go-fault"
+	"github.com/PlayerR9/go-fault/faults"
+)
+
+func fault() flt.Fault {
+	return nil
+}
+
+func pair() (int, flt.Fault) {
+	return 0, nil
+}
+
+func err() error {
+	return errors.New("x")
+}
+
+func count() int {
+	return 0
+}
+
+func use() flt.Fault {
+	fault()                     // want `the fault returned by fault is discarded`
+	pair()                      // want `the fault returned by pair is discarded`
+	faults.NewBadParameter("x") // want `the fault returned by faults.NewBadParameter is discarded`
+
+	_ = fault()
+	_, _ = pair()
+	err()
+	count()
+
+	f := fault()
+
+	return f
+}
@@ -0,0 +1,27 @@
+package embeds
+
+import flt "github.com/PlayerR9/go-fault"
+
+type missing struct { // want `missing embeds the fault Fault but does not declare an Embeds method`
+	flt.Fault
+}
+
+type declared struct {
+	flt.Fault
+}
+
+func (d declared) Embeds() flt.Fault {
+	return d.Fault
+}
+
+type promoted struct { // want `promoted embeds the fault declared but does not declare an Embeds method`
+	declared
+}
+
+type field struct {
+	cause flt.Fault
+}
+
+type plain struct {
+	count int
+}
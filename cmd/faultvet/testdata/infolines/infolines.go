@@ -0,0 +1,53 @@
+package infolines
+
+import flt "github.com/PlayerR9/go-fault"
+
+// explicit calls the InfoLines of its embedded fault explicitly.
+type explicit struct {
+	flt.Fault
+}
+
+func (e explicit) Embeds() flt.Fault {
+	return e.Fault
+}
+
+func (e explicit) InfoLines() []string {
+	return append([]string{"- explicit"}, e.Fault.InfoLines()...) // want `InfoLines calls the InfoLines of the embedded fault`
+}
+
+// promoted calls the InfoLines that its embedded struct promotes from its fault.
+type promoted struct {
+	explicit
+}
+
+func (p promoted) Embeds() flt.Fault {
+	return p.explicit
+}
+
+func (p promoted) InfoLines() []string {
+	return p.explicit.Fault.InfoLines() // want `InfoLines calls the InfoLines of the embedded fault`
+}
+
+// caused calls the InfoLines of a fault it does not embed.
+type caused struct {
+	flt.Fault
+
+	cause flt.Fault
+}
+
+func (c caused) Embeds() flt.Fault {
+	return c.Fault
+}
+
+func (c caused) InfoLines() []string {
+	return c.cause.InfoLines()
+}
+
+// plain does not embed a fault.
+type plain struct {
+	inner flt.Fault
+}
+
+func (p plain) InfoLines() []string {
+	return p.inner.InfoLines()
+}
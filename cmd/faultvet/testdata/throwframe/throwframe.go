@@ -0,0 +1,31 @@
+package throwframe
+
+import (
+	flt "github.com/PlayerR9/go-fault"
+	"github.com/PlayerR9/go-fault/faults"
+)
+
+type Set struct{}
+
+func Load() flt.Fault {
+	return faults.Throw(faults.NewBadParameter("x"), "Save") // want `the frame "Save" does not match the enclosing function Load`
+}
+
+func Literal() func() flt.Fault {
+	return func() flt.Fault {
+		return faults.Throw(faults.NewBadParameter("x"), "func1") // want `the frame "func1" does not match the enclosing function Literal`
+	}
+}
+
+func (s *Set) Get(frame string) flt.Fault {
+	fault := faults.NewBadParameter("x")
+
+	fault = faults.Throw(fault, "Get")
+	fault = faults.Throw(fault, "Get()")
+	fault = faults.Throw(fault, "Set.Get")
+	fault = faults.Throw(fault, "(*Set).Get")
+	fault = faults.Throw(fault, "(*Set).Get(key)")
+	fault = faults.Throw(fault, "example.com/app.(*Set).Get")
+
+	return faults.Throw(fault, frame)
+}
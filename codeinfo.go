@@ -0,0 +1,48 @@
+package fault
+
+import "fmt"
+
+// CodeInfo is the metadata of a fault code. The code types generated by faultcodegen
+// carry it. (See cmd/faultcodegen.)
+type CodeInfo struct {
+	// Description is the human-readable description of the code.
+	Description string
+
+	// Level is the level of the faults of that code unless specified otherwise.
+	Level FaultLevel
+
+	// HTTPStatus is the HTTP status code that best matches the code. Zero if unspecified.
+	HTTPStatus int
+
+	// DocURL is the URL of the documentation of the code. Empty if unspecified.
+	DocURL string
+}
+
+// CodeInfoer is implemented by the fault codes that carry metadata.
+type CodeInfoer interface {
+	// Info returns the metadata of the code.
+	//
+	// Returns:
+	//   - CodeInfo: The metadata of the code. Its level is UnknownLevel if the code is not
+	//     a known value of its type.
+	Info() CodeInfo
+}
+
+// InfoOf returns the metadata of a fault code.
+//
+// Parameters:
+//   - code: The code. (e.g., FaultDescriber.Code())
+//
+// Returns:
+//   - CodeInfo: The metadata of the code.
+//   - bool: True if the code carries metadata, false otherwise.
+func InfoOf(code fmt.Stringer) (CodeInfo, bool) {
+	infoer, ok := code.(CodeInfoer)
+	if !ok {
+		return CodeInfo{Level: UnknownLevel}, false
+	}
+
+	info := infoer.Info()
+
+	return info, info.Level != UnknownLevel
+}
@@ -3,7 +3,7 @@ package fault
 import "fmt"
 
 // New creates a new Fault given the code and its message. The timestamp is set to the
-// current time.
+// current time of the global clock. (See SetClock.)
 //
 // Parameters:
 //   - code: The code of the fault.
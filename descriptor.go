@@ -1,6 +1,8 @@
 package fault
 
 import (
+	"context"
+	"fmt"
 	"strings"
 	"time"
 )
@@ -19,6 +21,19 @@ type FaultDescriber interface {
 	//   - FaultLevel: The severity level of the fault.
 	Level() FaultLevel
 
+	// Code returns the code of the fault. The dynamic type of the returned value is the
+	// type of the code; which allows comparisons such as desc.Code() == fmt.Stringer(BadParameter).
+	//
+	// Returns:
+	//   - fmt.Stringer: The code of the fault.
+	Code() fmt.Stringer
+
+	// Message returns the message of the fault; without the level nor the code.
+	//
+	// Returns:
+	//   - string: The message of the fault.
+	Message() string
+
 	// Init initializes the fault describer by creating a new Fault instance.
 	//
 	// Returns:
@@ -36,6 +51,9 @@ type faultDescriptor[C FaultCode] struct {
 
 	// msg informs about the nature of the fault.
 	msg string
+
+	// template tells whether msg is a template. (See ExpandMessage.)
+	template bool
 }
 
 // String implements the fmt.Stringer interface.
@@ -49,14 +67,27 @@ type faultDescriptor[C FaultCode] struct {
 //   - <code>: The code of the fault.
 //   - <msg>: The message of the fault.
 func (fd faultDescriptor[C]) String() string {
+	return FormatHeader(fd.level, fd.code, fd.msg)
+}
+
+// FormatHeader formats the first line of a fault.
+//
+// Parameters:
+//   - level: The level of the fault.
+//   - code: The code of the fault.
+//   - msg: The message of the fault.
+//
+// Returns:
+//   - string: The formatted line: "[<level>] (<code>) <msg>".
+func FormatHeader(level FaultLevel, code fmt.Stringer, msg string) string {
 	var builder strings.Builder
 
 	builder.WriteRune('[')
-	builder.WriteString(fd.level.String())
+	builder.WriteString(level.String())
 	builder.WriteString("] (")
-	builder.WriteString(fd.code.String())
+	builder.WriteString(code.String())
 	builder.WriteString(") ")
-	builder.WriteString(fd.msg)
+	builder.WriteString(msg)
 
 	return builder.String()
 }
@@ -66,21 +97,59 @@ func (fd faultDescriptor[C]) Level() FaultLevel {
 	return fd.level
 }
 
+// Code implements the FaultDescriber interface.
+func (fd faultDescriptor[C]) Code() fmt.Stringer {
+	return fd.code
+}
+
+// Message implements the FaultDescriber interface.
+func (fd faultDescriptor[C]) Message() string {
+	return fd.msg
+}
+
+// IsTemplate tells whether the message of the descriptor is a template. (See
+// NewTemplateDescriptor.)
+//
+// Returns:
+//   - bool: True if the message is a template, false otherwise.
+func (fd faultDescriptor[C]) IsTemplate() bool {
+	return fd.template
+}
+
 // Init implements the FaultDescriber interface.
+//
+// A CreateEvent is emitted to GlobalHooks for the new fault.
 func (fd *faultDescriptor[C]) Init() Fault {
 	if fd == nil {
 		return nil
 	}
 
+	fault := fd.newFault(Now())
+	EmitCreate(context.Background(), fault)
+
+	return fault
+}
+
+// newFault creates a new fault from the descriptor without emitting any event.
+//
+// Parameters:
+//   - timestamp: The time when the fault occurred.
+//
+// Returns:
+//   - *BaseFault: The new fault. Never returns nil.
+func (fd *faultDescriptor[C]) newFault(timestamp time.Time) *BaseFault {
 	return &BaseFault{
 		descriptor: fd,
-		timestamp:  time.Now(),
+		timestamp:  timestamp,
 	}
 }
 
 // NewDescriptor creates a new FaultDescriber instance. Each descriptor is unique and
 // read-only. As such, comparation can only be done with pointer equality.
 //
+// The message is rendered as-is; braces included. (See NewTemplateDescriptor for
+// messages with placeholders.)
+//
 // Parameters:
 //   - level: The level of the fault.
 //   - code: The code of the fault.
@@ -95,3 +164,154 @@ func NewDescriptor[C FaultCode](level FaultLevel, code C, msg string) FaultDescr
 		msg:   msg,
 	}
 }
+
+// NewTemplateDescriptor is like NewDescriptor but the message is a template whose
+// placeholders, such as "{key}", are filled from the context of each fault when it is
+// rendered. (See ExpandMessage.) This allows a single descriptor to be shared by all the
+// occurrences of a fault while the rendered message still shows the specifics.
+//
+// Parameters:
+//   - level: The level of the fault.
+//   - code: The code of the fault.
+//   - template: The message template of the fault. (e.g., "index ({index}) is out of
+//     range")
+//
+// Returns:
+//   - FaultDescriber: The new FaultDescriber. Never returns nil.
+func NewTemplateDescriptor[C FaultCode](level FaultLevel, code C, template string) FaultDescriber {
+	return &faultDescriptor[C]{
+		level:    level,
+		code:     code,
+		msg:      template,
+		template: true,
+	}
+}
+
+// IsTemplate checks whether the message of the descriptor is a template; that is,
+// whether its placeholders are to be filled. (See NewTemplateDescriptor.)
+//
+// Parameters:
+//   - desc: The descriptor.
+//
+// Returns:
+//   - bool: True if the message is a template, false otherwise. False if desc is nil or
+//     does not have an IsTemplate method.
+func IsTemplate(desc FaultDescriber) bool {
+	t, ok := desc.(interface{ IsTemplate() bool })
+	return ok && t.IsTemplate()
+}
+
+// RecordedCode is the code of a fault that was restored from a record (e.g., a JSON log)
+// and whose original Go type is not available.
+type RecordedCode struct {
+	// Type is the name of the original type of the code. (e.g., "fault.StandardCode")
+	Type string
+
+	// Name is the string representation of the code.
+	Name string
+
+	// Value is the integer value of the code.
+	Value int
+}
+
+// String implements the fmt.Stringer interface.
+func (rc RecordedCode) String() string {
+	return rc.Name
+}
+
+// recordedDescriptor is the descriptor of a restored fault.
+type recordedDescriptor struct {
+	// level indicates the severity level of the fault.
+	level FaultLevel
+
+	// code specifies the broader category that the fault belongs to.
+	code RecordedCode
+
+	// msg informs about the nature of the fault.
+	msg string
+
+	// template tells whether msg is a template. (See ExpandMessage.)
+	template bool
+}
+
+// String implements the fmt.Stringer interface.
+func (rd recordedDescriptor) String() string {
+	return FormatHeader(rd.level, rd.code, rd.msg)
+}
+
+// Level implements the FaultDescriber interface.
+func (rd recordedDescriptor) Level() FaultLevel {
+	return rd.level
+}
+
+// Code implements the FaultDescriber interface.
+//
+// The dynamic type of the returned value is RecordedCode.
+func (rd recordedDescriptor) Code() fmt.Stringer {
+	return rd.code
+}
+
+// Message implements the FaultDescriber interface.
+func (rd recordedDescriptor) Message() string {
+	return rd.msg
+}
+
+// IsTemplate tells whether the message of the descriptor is a template. (See
+// NewRecordedTemplateDescriptor.)
+//
+// Returns:
+//   - bool: True if the message is a template, false otherwise.
+func (rd recordedDescriptor) IsTemplate() bool {
+	return rd.template
+}
+
+// Init implements the FaultDescriber interface.
+//
+// A CreateEvent is emitted to GlobalHooks for the new fault.
+func (rd *recordedDescriptor) Init() Fault {
+	if rd == nil {
+		return nil
+	}
+
+	fault := NewBase(rd)
+	EmitCreate(context.Background(), fault)
+
+	return fault
+}
+
+// NewRecordedDescriptor creates the descriptor of a fault that is restored from a record.
+// (See Restore.)
+//
+// Parameters:
+//   - level: The level of the fault.
+//   - code: The code of the fault.
+//   - msg: The message of the fault.
+//
+// Returns:
+//   - FaultDescriber: The new FaultDescriber. Never returns nil.
+func NewRecordedDescriptor(level FaultLevel, code RecordedCode, msg string) FaultDescriber {
+	return &recordedDescriptor{
+		level: level,
+		code:  code,
+		msg:   msg,
+	}
+}
+
+// NewRecordedTemplateDescriptor is like NewRecordedDescriptor but the message is a
+// template. (See NewTemplateDescriptor.)
+//
+// Parameters:
+//   - level: The level of the fault.
+//   - code: The code of the fault.
+//   - template: The message template of the fault.
+//
+// Returns:
+//   - FaultDescriber: The new FaultDescriber. Never returns nil.
+func NewRecordedTemplateDescriptor(level FaultLevel, code RecordedCode, template string) FaultDescriber {
+	return &recordedDescriptor{
+		level:    level,
+		code:     code,
+		msg:      template,
+		template: true,
+	}
+}
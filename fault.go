@@ -184,23 +184,58 @@ func InfoLines(fault Fault) []string {
 	return lines
 }
 
-// Unwrap extracts the underlying fault from a Fault. It doesn't affect the embedding tower and ignores the error
-// if it does not implement Unwrap() Fault method.
+// InfoLinesIn is like InfoLines but faults in the embedding tower that implement the
+// LocalizedInfoer interface have their additional information translated with tr.
 //
 // Parameters:
-//   - fault: The fault to unwrap.
+//   - fault: The fault whose additional information are to be written.
+//   - tr: The translator to use. If nil, no translation is done.
 //
 // Returns:
-//   - Fault: The underlying fault.
-func Unwrap(fault Fault) Fault {
+//   - []string: The fault's additional information.
+func InfoLinesIn(fault Fault, tr Translator) []string {
 	if fault == nil {
 		return nil
 	}
 
-	uw, ok := fault.(interface{ Unwrap() Fault })
-	if !ok {
-		return nil
+	tower := EmbeddingTower(fault)
+
+	var lines []string
+
+	for _, elem := range tower {
+		var tmp []string
+
+		li, ok := elem.(LocalizedInfoer)
+		if ok {
+			tmp = li.InfoLinesIn(tr)
+		} else {
+			tmp = elem.InfoLines()
+		}
+
+		lines = append(lines, tmp...)
+	}
+
+	return lines
+}
+
+// Unwrap extracts the underlying fault from a Fault; that is, its cause. The first fault
+// of the embedding tower, from the outermost one, that implements the Unwrap() Fault
+// method is unwrapped.
+//
+// Parameters:
+//   - fault: The fault to unwrap.
+//
+// Returns:
+//   - Fault: The underlying fault. Nil if the fault has none.
+func Unwrap(fault Fault) Fault {
+	for fault != nil {
+		uw, ok := fault.(interface{ Unwrap() Fault })
+		if ok {
+			return uw.Unwrap()
+		}
+
+		fault = fault.Embeds()
 	}
 
-	return uw.Unwrap()
+	return nil
 }
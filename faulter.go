@@ -27,6 +27,10 @@ type BaseFault struct {
 
 	// context is the context of the fault.
 	context map[string]any
+
+	// sensitive holds the keys of the context that have been marked as sensitive on
+	// this fault, along with how their values are hidden.
+	sensitive map[string]RedactMode
 }
 
 // Embeds implements the Fault interface.
@@ -49,35 +53,55 @@ func (bf BaseFault) Embeds() Fault {
 // "- <stack trace>"
 //
 // Where:
-//   - <timestamp>: The time when the fault occurred.
+//   - <timestamp>: The time when the fault occurred. (See FormatTimestamp.)
 //   - <suggestion>: One or more possible solutions or actions that can be taken
 //     to resolve the fault.
 //   - <stack trace>: The stack trace of the fault.
 func (bf BaseFault) InfoLines() []string {
+	return bf.InfoLinesIn(nil)
+}
+
+// InfoLinesIn implements the LocalizedInfoer interface.
+//
+// The labels and the suggestions are translated with tr; if tr is nil, they are left
+// as-is. Sensitive context values are redacted unless tr requests an unredacted view.
+// (See Unredacted.) See InfoLines for the format.
+func (bf BaseFault) InfoLinesIn(tr Translator) []string {
+	translate := func(text string) string {
+		if tr == nil {
+			return text
+		}
+
+		return tr.Translate(text)
+	}
+
 	var lines []string
 
 	if !bf.timestamp.IsZero() {
-		lines = append(lines, "Occurred at: "+bf.timestamp.String())
+		lines = append(lines, translate("Occurred at:")+" "+FormatTimestamp(bf.timestamp))
 	}
 
 	if len(bf.suggestions) > 0 {
-		lines = append(lines, "Suggestions:")
+		lines = append(lines, translate("Suggestions:"))
 
 		for _, suggestion := range bf.suggestions {
-			lines = append(lines, "- "+suggestion)
+			lines = append(lines, "- "+translate(suggestion))
 		}
 	}
 
 	if len(bf.context) > 0 {
-		lines = append(lines, "Context:")
+		lines = append(lines, translate("Context:"))
 
-		for k, v := range bf.context {
+		show := ShowsSensitive(tr)
+
+		for _, k := range bf.Keys() {
+			v, _ := bf.DisplayValue(k, show)
 			lines = append(lines, fmt.Sprintf("- %s: %v", k, v))
 		}
 	}
 
 	if len(bf.stack_trace) > 0 {
-		lines = append(lines, "Stack trace:")
+		lines = append(lines, translate("Stack trace:"))
 
 		trace := make([]string, len(bf.stack_trace), len(bf.stack_trace)+1)
 		copy(trace, bf.stack_trace)
@@ -91,6 +115,13 @@ func (bf BaseFault) InfoLines() []string {
 	return lines
 }
 
+// AppendFrame appends a frame to the stack trace of the fault.
+//
+// Parameters:
+//   - frame: The frame to append.
+//
+// Returns:
+//   - bool: True if the frame was appended, false if the receiver is nil.
 func (bf *BaseFault) AppendFrame(frame string) bool {
 	if bf == nil {
 		return false
@@ -101,6 +132,258 @@ func (bf *BaseFault) AppendFrame(frame string) bool {
 	return true
 }
 
+// Error implements the error interface.
+//
+// If the descriptor's message is a template, its placeholders are filled with the values
+// of the fault's context; with the sensitive values redacted. See ExpandMessage for the
+// syntax of the placeholders.
 func (bf BaseFault) Error() string {
-	return bf.descriptor.String()
+	msg := bf.descriptor.Message()
+
+	if IsTemplate(bf.descriptor) {
+		msg = ExpandMessage(msg, bf.RedactedValue)
+	}
+
+	return FormatHeader(bf.descriptor.Level(), bf.descriptor.Code(), msg)
+}
+
+// Descriptor returns the descriptor of the fault.
+//
+// Returns:
+//   - FaultDescriber: The descriptor of the fault.
+func (bf BaseFault) Descriptor() FaultDescriber {
+	return bf.descriptor
+}
+
+// Timestamp returns the time when the fault occurred.
+//
+// Returns:
+//   - time.Time: The time when the fault occurred.
+func (bf BaseFault) Timestamp() time.Time {
+	return bf.timestamp
+}
+
+// Suggestions returns a copy of the suggestions of the fault.
+//
+// Returns:
+//   - []string: The suggestions of the fault.
+func (bf BaseFault) Suggestions() []string {
+	return slices.Clone(bf.suggestions)
+}
+
+// AddSuggestions appends the given suggestions to the fault.
+//
+// Parameters:
+//   - suggestions: The suggestions to append.
+//
+// Returns:
+//   - bool: True if the suggestions were appended, false if the receiver is nil.
+func (bf *BaseFault) AddSuggestions(suggestions ...string) bool {
+	if bf == nil {
+		return false
+	}
+
+	bf.suggestions = append(bf.suggestions, suggestions...)
+
+	return true
+}
+
+// StackTrace returns a copy of the stack trace of the fault, from the first frame
+// appended to the last.
+//
+// Returns:
+//   - []string: The stack trace of the fault.
+func (bf BaseFault) StackTrace() []string {
+	return slices.Clone(bf.stack_trace)
+}
+
+// Value returns the value associated with the given key in the fault's context.
+//
+// Parameters:
+//   - key: The key to look for.
+//
+// Returns:
+//   - any: The value of the key.
+//   - bool: True if the key exists, false otherwise.
+func (bf BaseFault) Value(key string) (any, bool) {
+	if len(bf.context) == 0 {
+		return nil, false
+	}
+
+	value, ok := bf.context[key]
+	return value, ok
+}
+
+// RedactedValue is like Value but the value is redacted if it is sensitive. See
+// DisplayValue.
+//
+// Parameters:
+//   - key: The key to look for.
+//
+// Returns:
+//   - any: The value of the key; redacted if it is sensitive.
+//   - bool: True if the key exists, false otherwise.
+func (bf BaseFault) RedactedValue(key string) (any, bool) {
+	return bf.DisplayValue(key, false)
+}
+
+// DisplayValue returns the value of the key as it should be rendered.
+//
+// A value is redacted when the key has been marked as sensitive on the fault, when the key
+// matches a global sensitive pattern (see RegisterSensitiveKeys), or when the value
+// implements the Redactor interface.
+//
+// Parameters:
+//   - key: The key to look for.
+//   - show: Whether to return the value unredacted.
+//
+// Returns:
+//   - any: The value of the key, as it should be rendered.
+//   - bool: True if the key exists, false otherwise.
+func (bf BaseFault) DisplayValue(key string, show bool) (any, bool) {
+	value, ok := bf.Value(key)
+	if !ok || show {
+		return value, ok
+	}
+
+	mode, ok := bf.Sensitivity(key)
+	if ok {
+		return RedactValue(value, mode), true
+	}
+
+	r, ok := value.(Redactor)
+	if ok {
+		return r.Redact(), true
+	}
+
+	return value, true
+}
+
+// MarkSensitive marks the key as sensitive on this fault. The key does not need to exist
+// yet.
+//
+// Parameters:
+//   - key: The key to mark.
+//   - mode: How the values of the key are hidden.
+//
+// Returns:
+//   - bool: True if the key was marked, false if the receiver is nil.
+func (bf *BaseFault) MarkSensitive(key string, mode RedactMode) bool {
+	if bf == nil {
+		return false
+	}
+
+	if bf.sensitive == nil {
+		bf.sensitive = make(map[string]RedactMode)
+	}
+
+	bf.sensitive[key] = mode
+
+	return true
+}
+
+// Sensitivity checks whether the key is sensitive; either because it was marked on this
+// fault or because it matches a global sensitive pattern.
+//
+// Parameters:
+//   - key: The key to check.
+//
+// Returns:
+//   - RedactMode: How the values of the key are hidden. Only valid if the key is sensitive.
+//   - bool: True if the key is sensitive, false otherwise.
+func (bf BaseFault) Sensitivity(key string) (RedactMode, bool) {
+	mode, ok := bf.sensitive[key]
+	if ok {
+		return mode, true
+	}
+
+	return SensitiveKey(key)
+}
+
+// SetKey associates the given value with the given key in the fault's context; overwriting
+// any previous value.
+//
+// Parameters:
+//   - key: The key to set.
+//   - value: The value to set.
+//
+// Returns:
+//   - bool: True if the key was set, false if the receiver is nil.
+func (bf *BaseFault) SetKey(key string, value any) bool {
+	if bf == nil {
+		return false
+	}
+
+	if bf.context == nil {
+		bf.context = make(map[string]any)
+	}
+
+	bf.context[key] = value
+
+	return true
+}
+
+// RemoveKey removes the given key from the fault's context. Does nothing if the key
+// does not exist.
+//
+// Parameters:
+//   - key: The key to remove.
+func (bf *BaseFault) RemoveKey(key string) {
+	if bf == nil {
+		return
+	}
+
+	delete(bf.context, key)
+}
+
+// Keys returns the keys of the fault's context in sorted order.
+//
+// Returns:
+//   - []string: The keys of the fault's context.
+func (bf BaseFault) Keys() []string {
+	if len(bf.context) == 0 {
+		return nil
+	}
+
+	keys := make([]string, 0, len(bf.context))
+
+	for k := range bf.context {
+		keys = append(keys, k)
+	}
+
+	slices.Sort(keys)
+
+	return keys
+}
+
+// NewBase creates a new fault from the descriptor, timestamped with the global clock.
+// (See SetClock.) Unlike FaultDescriber.Init, no event is emitted; so that constructors
+// can set the fault up before emitting its CreateEvent with EmitCreate.
+//
+// Parameters:
+//   - desc: The descriptor of the fault.
+//
+// Returns:
+//   - *BaseFault: The new fault. Never returns nil.
+func NewBase(desc FaultDescriber) *BaseFault {
+	return &BaseFault{
+		descriptor: desc,
+		timestamp:  Now(),
+	}
+}
+
+// Restore creates a fault that was previously recorded; such as one decoded from a log.
+// Unlike FaultDescriber.Init, the timestamp is given and no event is emitted.
+//
+// Parameters:
+//   - desc: The descriptor of the fault.
+//   - timestamp: The time when the fault occurred.
+//
+// Returns:
+//   - *BaseFault: The restored fault. Never returns nil.
+func Restore(desc FaultDescriber, timestamp time.Time) *BaseFault {
+	return &BaseFault{
+		descriptor: desc,
+		timestamp:  timestamp,
+	}
 }
@@ -0,0 +1,105 @@
+package faults
+
+import (
+	"context"
+
+	flt "github.com/PlayerR9/go-fault"
+)
+
+var (
+	// RequestIDKey is the key of the identifier of the request during which the fault
+	// occurred.
+	RequestIDKey Key[string] = NewKey[string]("request_id")
+
+	// TenantKey is the key of the tenant on whose behalf the fault occurred.
+	TenantKey Key[string] = NewKey[string]("tenant")
+
+	// TraceIDKey is the key of the identifier of the trace during which the fault occurred.
+	TraceIDKey Key[string] = NewKey[string]("trace_id")
+
+	// SpanIDKey is the key of the identifier of the span during which the fault occurred.
+	SpanIDKey Key[string] = NewKey[string]("span_id")
+
+	// UserIDKey is the key of the user on whose behalf the fault occurred. As it is
+	// personal data, it is rendered as a stable hash.
+	UserIDKey Key[string] = NewKey[string]("user_id", SensitiveHashed())
+)
+
+// ContextWith returns a copy of ctx that carries the key/value pair. Every fault created
+// with flt.NewCtx, or passed to FromCtx, with that context (or any context derived from
+// it) gets the pair in its context.
+//
+// Parameters:
+//   - ctx: The parent context. If nil, context.Background() is used.
+//   - key: The key to carry. Its options are applied to the faults as well.
+//   - value: The value to carry.
+//
+// Returns:
+//   - context.Context: The new context. Never returns nil.
+//
+// Example:
+//
+//	func Middleware(next http.Handler) http.Handler {
+//		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+//			ctx := faults.ContextWith(r.Context(), faults.RequestIDKey, r.Header.Get("X-Request-ID"))
+//			next.ServeHTTP(w, r.WithContext(ctx))
+//		})
+//	}
+func ContextWith[T any](ctx context.Context, key Key[T], value T) context.Context {
+	return flt.WithAmbient(ctx, flt.AmbientField{
+		Key:   key.name,
+		Value: value,
+		Apply: func(base *flt.BaseFault) {
+			_ = Set(base, key, value)
+		},
+	})
+}
+
+// FromCtx copies the key/value pairs carried by ctx into the fault's context. (See
+// ContextWith.)
+//
+// Parameters:
+//   - ctx: The context that carries the key/value pairs.
+//   - fault: The fault to copy the pairs into.
+//
+// Returns:
+//   - flt.Fault: The fault. Returns nil if the fault is nil.
+func FromCtx(ctx context.Context, fault flt.Fault) flt.Fault {
+	if fault == nil {
+		return nil
+	}
+
+	base, ok := Access[*flt.BaseFault](fault)
+	if !ok {
+		panic(flt.BadConstruction.Init())
+	}
+
+	_ = flt.ApplyAmbient(ctx, base)
+
+	return fault
+}
+
+// ValueFromCtx gets the value of the key carried by ctx.
+//
+// Parameters:
+//   - ctx: The context to look into.
+//   - key: The key to get the value of.
+//
+// Returns:
+//   - T: The value of the key.
+//   - bool: True if ctx carries the key and its value is of type T, false otherwise.
+func ValueFromCtx[T any](ctx context.Context, key Key[T]) (T, bool) {
+	zero := *new(T)
+
+	value, ok := flt.AmbientValue(ctx, key.name)
+	if !ok {
+		return zero, false
+	}
+
+	v, ok := value.(T)
+	if !ok {
+		return zero, false
+	}
+
+	return v, true
+}
@@ -1,11 +1,206 @@
 package faults
 
 import (
-	"fmt"
-
 	flt "github.com/PlayerR9/go-fault"
 )
 
+var (
+	// NilReceiver is the descriptor of the faults returned by NewNilReceiver.
+	NilReceiver flt.FaultDescriber
+
+	// NilParameter is the descriptor of the faults returned by NewNilParameter. Its
+	// message is filled with the "parameter" key.
+	NilParameter flt.FaultDescriber
+
+	// NoSuchKey is the descriptor of the faults returned by NewNoSuchKey. Its message is
+	// filled with the "key" key.
+	NoSuchKey flt.FaultDescriber
+
+	// ParameterKey is the key of the name of the parameter of NilParameter faults.
+	ParameterKey Key[string] = NewKey[string]("parameter")
+
+	// MissingKey is the key of the key that was not found of NoSuchKey faults.
+	MissingKey Key[string] = NewKey[string]("key")
+
+	// IndexOutOfRange is the descriptor of the ErrOutOfRange faults. Its message is filled
+	// with the "index" key.
+	IndexOutOfRange flt.FaultDescriber
+
+	// TypeMismatch is the descriptor of the ErrTypeMismatch faults. Its message is filled
+	// with the "expected" and "actual" keys.
+	TypeMismatch flt.FaultDescriber
+
+	// TimedOut is the descriptor of the ErrTimeout faults. Its message is filled with the
+	// "operation" key.
+	TimedOut flt.FaultDescriber
+
+	// IndexKey is the key of the index of IndexOutOfRange faults.
+	IndexKey Key[int] = NewKey[int]("index")
+
+	// ExpectedTypeKey is the key of the name of the expected type of TypeMismatch faults.
+	ExpectedTypeKey Key[string] = NewKey[string]("expected")
+
+	// ActualTypeKey is the key of the name of the actual type of TypeMismatch faults.
+	ActualTypeKey Key[string] = NewKey[string]("actual")
+
+	// OperationKey is the key of the name of the operation of TimedOut faults.
+	OperationKey Key[string] = NewKey[string]("operation")
+
+	// standardDescriptors are the descriptors of the faults that the constructors of the
+	// standard codes, like NewNotFound, create when given no message; by code. (See
+	// StandardDescriptor.)
+	standardDescriptors map[flt.StandardCode]flt.FaultDescriber
+)
+
+func init() {
+	NilReceiver = flt.NewDescriptor(flt.ERROR, flt.OperationFailed, "receiver must be non-nil")
+	NilParameter = flt.NewTemplateDescriptor(flt.ERROR, flt.BadParameter, "parameter ({parameter:q}) must be non-nil")
+	NoSuchKey = flt.NewTemplateDescriptor(flt.ERROR, flt.OperationFailed, "the specified key ({key:q}) does not exist")
+	IndexOutOfRange = flt.NewTemplateDescriptor(flt.ERROR, flt.OutOfRange, "index ({index}) is out of range")
+	TypeMismatch = flt.NewTemplateDescriptor(flt.ERROR, flt.BadParameter, "expected a value of type {expected}, got one of type {actual}")
+	TimedOut = flt.NewTemplateDescriptor(flt.ERROR, flt.Timeout, "operation ({operation:q}) timed out")
+
+	standardDescriptors = map[flt.StandardCode]flt.FaultDescriber{
+		flt.NotFound:           flt.NewDescriptor(flt.NotFound.Info().Level, flt.NotFound, "the requested entity was not found"),
+		flt.AlreadyExists:      flt.NewDescriptor(flt.AlreadyExists.Info().Level, flt.AlreadyExists, "the entity already exists"),
+		flt.PermissionDenied:   flt.NewDescriptor(flt.PermissionDenied.Info().Level, flt.PermissionDenied, "permission denied"),
+		flt.Unauthenticated:    flt.NewDescriptor(flt.Unauthenticated.Info().Level, flt.Unauthenticated, "the caller is not authenticated"),
+		flt.Timeout:            flt.NewDescriptor(flt.Timeout.Info().Level, flt.Timeout, "the operation timed out"),
+		flt.Canceled:           flt.NewDescriptor(flt.Canceled.Info().Level, flt.Canceled, "the operation was canceled"),
+		flt.Unavailable:        flt.NewDescriptor(flt.Unavailable.Info().Level, flt.Unavailable, "the service is unavailable"),
+		flt.ResourceExhausted:  flt.NewDescriptor(flt.ResourceExhausted.Info().Level, flt.ResourceExhausted, "a resource has been exhausted"),
+		flt.FailedPrecondition: flt.NewDescriptor(flt.FailedPrecondition.Info().Level, flt.FailedPrecondition, "the system is not in the state required by the operation"),
+		flt.Aborted:            flt.NewDescriptor(flt.Aborted.Info().Level, flt.Aborted, "the operation was aborted"),
+		flt.OutOfRange:         flt.NewDescriptor(flt.OutOfRange.Info().Level, flt.OutOfRange, "the operation was attempted past the valid range"),
+		flt.Unimplemented:      flt.NewDescriptor(flt.Unimplemented.Info().Level, flt.Unimplemented, "the operation is not implemented"),
+		flt.Internal:           flt.NewDescriptor(flt.Internal.Info().Level, flt.Internal, "an internal error occurred"),
+		flt.DataLoss:           flt.NewDescriptor(flt.DataLoss.Info().Level, flt.DataLoss, "data has been lost or corrupted"),
+	}
+
+	// Translations of the messages of the constructors.
+
+	DefaultCatalog.SetMessage("fr", NilReceiver, "le récepteur doit être non nul")
+	DefaultCatalog.SetMessage("es", NilReceiver, "el receptor no debe ser nulo")
+
+	DefaultCatalog.SetMessage("fr", NilParameter, "le paramètre ({parameter:q}) doit être non nul")
+	DefaultCatalog.SetMessage("es", NilParameter, "el parámetro ({parameter:q}) no debe ser nulo")
+
+	DefaultCatalog.SetMessage("fr", NoSuchKey, "la clé spécifiée ({key:q}) n'existe pas")
+	DefaultCatalog.SetMessage("es", NoSuchKey, "la clave especificada ({key:q}) no existe")
+
+	DefaultCatalog.SetMessage("fr", IndexOutOfRange, "l'indice ({index}) est hors limites")
+	DefaultCatalog.SetMessage("es", IndexOutOfRange, "el índice ({index}) está fuera de rango")
+
+	DefaultCatalog.SetMessage("fr", TypeMismatch, "une valeur de type {expected} était attendue, mais elle est de type {actual}")
+	DefaultCatalog.SetMessage("es", TypeMismatch, "se esperaba un valor de tipo {expected}, pero es de tipo {actual}")
+
+	DefaultCatalog.SetMessage("fr", TimedOut, "l'opération ({operation:q}) a expiré")
+	DefaultCatalog.SetMessage("es", TimedOut, "la operación ({operation:q}) excedió el tiempo de espera")
+
+	// Default messages of the standard codes.
+
+	DefaultCatalog.SetMessage("fr", standardDescriptors[flt.NotFound], "l'entité demandée est introuvable")
+	DefaultCatalog.SetMessage("es", standardDescriptors[flt.NotFound], "no se encontró la entidad solicitada")
+
+	DefaultCatalog.SetMessage("fr", standardDescriptors[flt.AlreadyExists], "l'entité existe déjà")
+	DefaultCatalog.SetMessage("es", standardDescriptors[flt.AlreadyExists], "la entidad ya existe")
+
+	DefaultCatalog.SetMessage("fr", standardDescriptors[flt.PermissionDenied], "permission refusée")
+	DefaultCatalog.SetMessage("es", standardDescriptors[flt.PermissionDenied], "permiso denegado")
+
+	DefaultCatalog.SetMessage("fr", standardDescriptors[flt.Unauthenticated], "l'appelant n'est pas authentifié")
+	DefaultCatalog.SetMessage("es", standardDescriptors[flt.Unauthenticated], "el llamante no está autenticado")
+
+	DefaultCatalog.SetMessage("fr", standardDescriptors[flt.Timeout], "l'opération a expiré")
+	DefaultCatalog.SetMessage("es", standardDescriptors[flt.Timeout], "la operación excedió el tiempo de espera")
+
+	DefaultCatalog.SetMessage("fr", standardDescriptors[flt.Canceled], "l'opération a été annulée")
+	DefaultCatalog.SetMessage("es", standardDescriptors[flt.Canceled], "la operación fue cancelada")
+
+	DefaultCatalog.SetMessage("fr", standardDescriptors[flt.Unavailable], "le service est indisponible")
+	DefaultCatalog.SetMessage("es", standardDescriptors[flt.Unavailable], "el servicio no está disponible")
+
+	DefaultCatalog.SetMessage("fr", standardDescriptors[flt.ResourceExhausted], "une ressource est épuisée")
+	DefaultCatalog.SetMessage("es", standardDescriptors[flt.ResourceExhausted], "se agotó un recurso")
+
+	DefaultCatalog.SetMessage("fr", standardDescriptors[flt.FailedPrecondition], "le système n'est pas dans l'état requis par l'opération")
+	DefaultCatalog.SetMessage("es", standardDescriptors[flt.FailedPrecondition], "el sistema no está en el estado requerido por la operación")
+
+	DefaultCatalog.SetMessage("fr", standardDescriptors[flt.Aborted], "l'opération a été abandonnée")
+	DefaultCatalog.SetMessage("es", standardDescriptors[flt.Aborted], "la operación fue abortada")
+
+	DefaultCatalog.SetMessage("fr", standardDescriptors[flt.OutOfRange], "l'opération a dépassé la plage valide")
+	DefaultCatalog.SetMessage("es", standardDescriptors[flt.OutOfRange], "la operación superó el rango válido")
+
+	DefaultCatalog.SetMessage("fr", standardDescriptors[flt.Unimplemented], "l'opération n'est pas implémentée")
+	DefaultCatalog.SetMessage("es", standardDescriptors[flt.Unimplemented], "la operación no está implementada")
+
+	DefaultCatalog.SetMessage("fr", standardDescriptors[flt.Internal], "une erreur interne s'est produite")
+	DefaultCatalog.SetMessage("es", standardDescriptors[flt.Internal], "ocurrió un error interno")
+
+	DefaultCatalog.SetMessage("fr", standardDescriptors[flt.DataLoss], "des données ont été perdues ou corrompues")
+	DefaultCatalog.SetMessage("es", standardDescriptors[flt.DataLoss], "se perdieron o corrompieron datos")
+
+	// Messages of the faults that do not have a shared descriptor.
+
+	DefaultCatalog.SetText("fr", "something went wrong", "une erreur s'est produite")
+	DefaultCatalog.SetText("es", "something went wrong", "algo salió mal")
+
+	DefaultCatalog.SetText("fr", "a panic occurred", "une panique s'est produite")
+	DefaultCatalog.SetText("es", "a panic occurred", "ocurrió un pánico")
+
+	// Suggestions.
+
+	DefaultCatalog.SetText("fr", "Did you forgot to initialize the receiver?", "Avez-vous oublié d'initialiser le récepteur ?")
+	DefaultCatalog.SetText("es", "Did you forgot to initialize the receiver?", "¿Olvidó inicializar el receptor?")
+
+	DefaultCatalog.SetText("fr", "You may have forgotten to cast the value to the correct type or the desired key does not exist",
+		"Vous avez peut-être oublié de convertir la valeur dans le bon type, ou la clé souhaitée n'existe pas")
+	DefaultCatalog.SetText("es", "You may have forgotten to cast the value to the correct type or the desired key does not exist",
+		"Puede que haya olvidado convertir el valor al tipo correcto, o que la clave deseada no exista")
+
+	// Labels.
+
+	DefaultCatalog.SetText("fr", "Occurred at:", "Survenu le :")
+	DefaultCatalog.SetText("es", "Occurred at:", "Ocurrido el:")
+
+	DefaultCatalog.SetText("fr", "Suggestions:", "Suggestions :")
+	DefaultCatalog.SetText("es", "Suggestions:", "Sugerencias:")
+
+	DefaultCatalog.SetText("fr", "Context:", "Contexte :")
+	DefaultCatalog.SetText("es", "Context:", "Contexto:")
+
+	DefaultCatalog.SetText("fr", "Stack trace:", "Pile d'appels :")
+	DefaultCatalog.SetText("es", "Stack trace:", "Traza de la pila:")
+
+	DefaultCatalog.SetText("fr", "Error:", "Erreur :")
+	DefaultCatalog.SetText("es", "Error:", "Error:")
+
+	DefaultCatalog.SetText("fr", "no error provided", "aucune erreur fournie")
+	DefaultCatalog.SetText("es", "no error provided", "no se proporcionó ningún error")
+
+	DefaultCatalog.SetText("fr", "Value:", "Valeur :")
+	DefaultCatalog.SetText("es", "Value:", "Valor:")
+
+	DefaultCatalog.SetText("fr", "Known keys:", "Clés connues :")
+	DefaultCatalog.SetText("es", "Known keys:", "Claves conocidas:")
+
+	DefaultCatalog.SetText("fr", "Valid range:", "Plage valide :")
+	DefaultCatalog.SetText("es", "Valid range:", "Rango válido:")
+
+	DefaultCatalog.SetText("fr", "Elapsed:", "Écoulé :")
+	DefaultCatalog.SetText("es", "Elapsed:", "Transcurrido:")
+
+	DefaultCatalog.SetText("fr", "Limit:", "Limite :")
+	DefaultCatalog.SetText("es", "Limit:", "Límite:")
+
+	DefaultCatalog.SetText("fr", "Caused by:", "Causé par :")
+	DefaultCatalog.SetText("es", "Caused by:", "Causado por:")
+
+	DefaultCatalog.SetText("fr", "Query:", "Requête :")
+	DefaultCatalog.SetText("es", "Query:", "Consulta:")
+}
+
 // NewNilReceiver creates a new OperationFailed fault.
 //
 // Parameters:
@@ -14,16 +209,10 @@ import (
 // Returns:
 //   - flt.Fault: The new flt.Fault. Never returns nil.
 func NewNilReceiver(opts ...FaultOption) flt.Fault {
-	desc := flt.NewDescriptor(flt.ERROR, flt.OperationFailed, "receiver must be non-nil")
-
-	fault := desc.Init()
+	fault := flt.NewBase(NilReceiver)
 	_ = SetSuggestions(fault, "Did you forgot to initialize the receiver?")
 
-	for _, opt := range opts {
-		opt(fault)
-	}
-
-	return fault
+	return apply(fault, opts)
 }
 
 // NewBadParameter creates a new BadParameter fault.
@@ -34,15 +223,31 @@ func NewNilReceiver(opts ...FaultOption) flt.Fault {
 // Returns:
 //   - flt.Fault: The new flt.Fault. Never returns nil.
 func NewBadParameter(msg string, opts ...FaultOption) flt.Fault {
-	desc := flt.NewDescriptor(flt.ERROR, flt.BadParameter, msg)
-
-	fault := desc.Init()
+	return apply(newBadParameter(msg), opts)
+}
 
-	for _, opt := range opts {
-		opt(fault)
-	}
+// newBadParameter is like NewBadParameter but no event is emitted; so that it can be
+// embedded by the constructors of other faults.
+//
+// Parameters:
+//   - msg: The message of the fault.
+//
+// Returns:
+//   - *flt.BaseFault: The new fault. Never returns nil.
+func newBadParameter(msg string) *flt.BaseFault {
+	return flt.NewBase(flt.NewDescriptor(flt.ERROR, flt.BadParameter, msg))
+}
 
-	return fault
+// newBadParameterTemplate is like newBadParameter but the message is a template. (See
+// flt.NewTemplateDescriptor.)
+//
+// Parameters:
+//   - template: The message template of the fault.
+//
+// Returns:
+//   - *flt.BaseFault: The new fault. Never returns nil.
+func newBadParameterTemplate(template string) *flt.BaseFault {
+	return flt.NewBase(flt.NewTemplateDescriptor(flt.ERROR, flt.BadParameter, template))
 }
 
 // NewNilParameter creates a new BadParameter fault.
@@ -51,17 +256,17 @@ func NewBadParameter(msg string, opts ...FaultOption) flt.Fault {
 //   - param_name: The name of the parameter.
 //
 // Returns:
-//   - flt.Fault: The new flt.Fault. Never returns nil.
+//   - flt.Fault: The new flt.Fault. Never returns nil. Its dynamic type is
+//     *ErrNilParameter.
 func NewNilParameter(param_name string, opts ...FaultOption) flt.Fault {
-	desc := flt.NewDescriptor(flt.ERROR, flt.BadParameter, fmt.Sprintf("parameter (%q) must be non-nil", param_name))
-
-	fault := desc.Init()
-
-	for _, opt := range opts {
-		opt(fault)
+	fault := &ErrNilParameter{
+		Fault: flt.NewBase(NilParameter),
+		Name:  param_name,
 	}
 
-	return fault
+	_ = Set(fault, ParameterKey, param_name)
+
+	return apply(fault, opts)
 }
 
 // NewInvalidUsage creates a new OperationFailed fault.
@@ -75,31 +280,243 @@ func NewNilParameter(param_name string, opts ...FaultOption) flt.Fault {
 func NewInvalidUsage(message, usage string, opts ...FaultOption) flt.Fault {
 	desc := flt.NewDescriptor(flt.ERROR, flt.OperationFailed, message)
 
-	fault := desc.Init()
+	fault := flt.NewBase(desc)
 	_ = SetSuggestions(fault, usage)
 
-	for _, opt := range opts {
-		opt(fault)
-	}
-
-	return fault
+	return apply(fault, opts)
 }
 
-// NewNoSuchKey creates a new OperationFailed fault.
+// NewNoSuchKey creates a new OperationFailed fault. It is like NewErrNoSuchKey when the
+// known keys are not known.
 //
 // Parameters:
 //   - key: The key that was not found.
 //
 // Returns:
-//   - flt.Fault: The new flt.Fault. Never returns nil.
+//   - flt.Fault: The new flt.Fault. Never returns nil. Its dynamic type is
+//     *ErrNoSuchKey.
 func NewNoSuchKey(key string, opts ...FaultOption) flt.Fault {
-	desc := flt.NewDescriptor(flt.ERROR, flt.OperationFailed, fmt.Sprintf("the specified key (%q) does not exist", key))
+	return NewErrNoSuchKey(key, nil, opts...)
+}
+
+// StandardDescriptor returns the shared descriptor of the faults that the constructor of
+// a standard code, like NewNotFound, creates when given no message. Its message is the
+// default message of the code; which DefaultCatalog translates.
+//
+// Parameters:
+//   - code: The standard code.
+//
+// Returns:
+//   - flt.FaultDescriber: The descriptor. Nil if the code has no constructor.
+//
+// Example:
+//
+//	ok := faults.Match(fault, faults.Descriptor(faults.StandardDescriptor(flt.NotFound)))
+func StandardDescriptor(code flt.StandardCode) flt.FaultDescriber {
+	return standardDescriptors[code]
+}
 
-	fault := desc.Init()
+// newStandard creates a new fault of the given code at its default level. (See
+// flt.CodeInfo.)
+//
+// Parameters:
+//   - code: The code of the fault.
+//   - msg: The message of the fault. If empty, the shared descriptor of the code is used.
+//     (See StandardDescriptor.)
+//   - opts: The options to apply to the fault.
+//
+// Returns:
+//   - flt.Fault: The new flt.Fault. Never returns nil.
+func newStandard(code flt.StandardCode, msg string, opts []FaultOption) flt.Fault {
+	var desc flt.FaultDescriber
 
-	for _, opt := range opts {
-		opt(fault)
+	if msg == "" {
+		desc = standardDescriptors[code]
+	} else {
+		desc = flt.NewDescriptor(code.Info().Level, code, msg)
 	}
 
-	return fault
+	return apply(flt.NewBase(desc), opts)
+}
+
+// NewNotFound creates a new NotFound fault; for when a requested entity does not exist.
+// Its level is ERROR.
+//
+// Parameters:
+//   - msg: The message of the fault. If empty, the default message of the code is
+//     used. (See StandardDescriptor.)
+//
+// Returns:
+//   - flt.Fault: The new flt.Fault. Never returns nil.
+func NewNotFound(msg string, opts ...FaultOption) flt.Fault {
+	return newStandard(flt.NotFound, msg, opts)
+}
+
+// NewAlreadyExists creates a new AlreadyExists fault; for when an entity that was meant
+// to be created already exists. Its level is ERROR.
+//
+// Parameters:
+//   - msg: The message of the fault. If empty, the default message of the code is
+//     used. (See StandardDescriptor.)
+//
+// Returns:
+//   - flt.Fault: The new flt.Fault. Never returns nil.
+func NewAlreadyExists(msg string, opts ...FaultOption) flt.Fault {
+	return newStandard(flt.AlreadyExists, msg, opts)
+}
+
+// NewPermissionDenied creates a new PermissionDenied fault; for when the caller is not
+// allowed to perform the operation. Its level is ERROR.
+//
+// Parameters:
+//   - msg: The message of the fault. If empty, the default message of the code is
+//     used. (See StandardDescriptor.)
+//
+// Returns:
+//   - flt.Fault: The new flt.Fault. Never returns nil.
+func NewPermissionDenied(msg string, opts ...FaultOption) flt.Fault {
+	return newStandard(flt.PermissionDenied, msg, opts)
+}
+
+// NewUnauthenticated creates a new Unauthenticated fault; for when the caller could not
+// be identified. Its level is ERROR.
+//
+// Parameters:
+//   - msg: The message of the fault. If empty, the default message of the code is
+//     used. (See StandardDescriptor.)
+//
+// Returns:
+//   - flt.Fault: The new flt.Fault. Never returns nil.
+func NewUnauthenticated(msg string, opts ...FaultOption) flt.Fault {
+	return newStandard(flt.Unauthenticated, msg, opts)
+}
+
+// NewTimeout creates a new Timeout fault; for when an operation did not complete before
+// its deadline. Its level is ERROR.
+//
+// Parameters:
+//   - msg: The message of the fault. If empty, the default message of the code is
+//     used. (See StandardDescriptor.)
+//
+// Returns:
+//   - flt.Fault: The new flt.Fault. Never returns nil.
+func NewTimeout(msg string, opts ...FaultOption) flt.Fault {
+	return newStandard(flt.Timeout, msg, opts)
+}
+
+// NewCanceled creates a new Canceled fault; for when an operation was canceled. Its level
+// is NOTICE.
+//
+// Parameters:
+//   - msg: The message of the fault. If empty, the default message of the code is
+//     used. (See StandardDescriptor.)
+//
+// Returns:
+//   - flt.Fault: The new flt.Fault. Never returns nil.
+func NewCanceled(msg string, opts ...FaultOption) flt.Fault {
+	return newStandard(flt.Canceled, msg, opts)
+}
+
+// NewUnavailable creates a new Unavailable fault; for when a service is temporarily
+// unable to handle the operation. Its level is ERROR.
+//
+// Parameters:
+//   - msg: The message of the fault. If empty, the default message of the code is
+//     used. (See StandardDescriptor.)
+//
+// Returns:
+//   - flt.Fault: The new flt.Fault. Never returns nil.
+func NewUnavailable(msg string, opts ...FaultOption) flt.Fault {
+	return newStandard(flt.Unavailable, msg, opts)
+}
+
+// NewResourceExhausted creates a new ResourceExhausted fault; for when a resource has run
+// out. Its level is WARNING.
+//
+// Parameters:
+//   - msg: The message of the fault. If empty, the default message of the code is
+//     used. (See StandardDescriptor.)
+//
+// Returns:
+//   - flt.Fault: The new flt.Fault. Never returns nil.
+func NewResourceExhausted(msg string, opts ...FaultOption) flt.Fault {
+	return newStandard(flt.ResourceExhausted, msg, opts)
+}
+
+// NewFailedPrecondition creates a new FailedPrecondition fault; for when the system is
+// not in the state required by the operation. Its level is ERROR.
+//
+// Parameters:
+//   - msg: The message of the fault. If empty, the default message of the code is
+//     used. (See StandardDescriptor.)
+//
+// Returns:
+//   - flt.Fault: The new flt.Fault. Never returns nil.
+func NewFailedPrecondition(msg string, opts ...FaultOption) flt.Fault {
+	return newStandard(flt.FailedPrecondition, msg, opts)
+}
+
+// NewAborted creates a new Aborted fault; for when an operation was aborted because of a
+// concurrency conflict. Its level is WARNING.
+//
+// Parameters:
+//   - msg: The message of the fault. If empty, the default message of the code is
+//     used. (See StandardDescriptor.)
+//
+// Returns:
+//   - flt.Fault: The new flt.Fault. Never returns nil.
+func NewAborted(msg string, opts ...FaultOption) flt.Fault {
+	return newStandard(flt.Aborted, msg, opts)
+}
+
+// NewOutOfRange creates a new OutOfRange fault; for when an operation was attempted past
+// a valid range. Its level is ERROR.
+//
+// Parameters:
+//   - msg: The message of the fault. If empty, the default message of the code is
+//     used. (See StandardDescriptor.)
+//
+// Returns:
+//   - flt.Fault: The new flt.Fault. Never returns nil.
+func NewOutOfRange(msg string, opts ...FaultOption) flt.Fault {
+	return newStandard(flt.OutOfRange, msg, opts)
+}
+
+// NewUnimplemented creates a new Unimplemented fault; for when an operation is not
+// implemented. Its level is ERROR.
+//
+// Parameters:
+//   - msg: The message of the fault. If empty, the default message of the code is
+//     used. (See StandardDescriptor.)
+//
+// Returns:
+//   - flt.Fault: The new flt.Fault. Never returns nil.
+func NewUnimplemented(msg string, opts ...FaultOption) flt.Fault {
+	return newStandard(flt.Unimplemented, msg, opts)
+}
+
+// NewInternal creates a new Internal fault; for when an invariant of the system has been
+// broken. Its level is ERROR.
+//
+// Parameters:
+//   - msg: The message of the fault. If empty, the default message of the code is
+//     used. (See StandardDescriptor.)
+//
+// Returns:
+//   - flt.Fault: The new flt.Fault. Never returns nil.
+func NewInternal(msg string, opts ...FaultOption) flt.Fault {
+	return newStandard(flt.Internal, msg, opts)
+}
+
+// NewDataLoss creates a new DataLoss fault; for when data has been lost or corrupted. Its
+// level is FATAL.
+//
+// Parameters:
+//   - msg: The message of the fault. If empty, the default message of the code is
+//     used. (See StandardDescriptor.)
+//
+// Returns:
+//   - flt.Fault: The new flt.Fault. Never returns nil.
+func NewDataLoss(msg string, opts ...FaultOption) flt.Fault {
+	return newStandard(flt.DataLoss, msg, opts)
 }
@@ -0,0 +1,168 @@
+// Package diag attaches source spans to faults and renders them in the style of modern
+// compilers; with the offending source lines underlined.
+//
+// Example:
+//
+//	err := diag.At(faults.NewBadParameter("unexpected token"), span, "expected an expression").
+//		Also(fn_span, "in this function")
+//
+//	_ = diag.Render(os.Stderr, err, diag.FileSources())
+//
+// Output:
+//
+//	error[BadParameter]: unexpected token
+//	 --> main.sd:3:9
+//	  |
+//	2 | fn main() {
+//	  | --------- in this function
+//	3 |     let x = ;
+//	  |             ^ expected an expression
+package diag
+
+import (
+	flt "github.com/PlayerR9/go-fault"
+	"github.com/PlayerR9/go-fault/faults"
+)
+
+// Label is a span of a source with a message that explains its role in the fault.
+type Label struct {
+	// Span is the labeled span.
+	Span Span
+
+	// Message is the message of the label. May be empty.
+	Message string
+}
+
+// Diagnostic is a fault located in source files. Its primary label is where the fault
+// occurred and its secondary labels, if any, are related locations.
+type Diagnostic struct {
+	flt.Fault
+
+	// Primary is the location of the fault.
+	Primary Label
+
+	// Secondary are the related locations, in order of addition.
+	Secondary []Label
+}
+
+// Embeds implements the flt.Fault interface.
+func (d Diagnostic) Embeds() flt.Fault {
+	return d.Fault
+}
+
+// InfoLines implements the flt.Fault interface.
+//
+// Format:
+//
+//	"--> <span>: <label>"
+//	"::: <span>: <label>"
+//	"::: ..."
+//
+// Where the first line is the primary label and the others are the secondary labels.
+// The ": <label>" part is omitted for the labels without message. (See Render for the
+// rendering with the source lines.)
+func (d Diagnostic) InfoLines() []string {
+	lines := make([]string, 0, 1+len(d.Secondary))
+
+	lines = append(lines, labelLine("-->", d.Primary))
+
+	for _, label := range d.Secondary {
+		lines = append(lines, labelLine(":::", label))
+	}
+
+	return lines
+}
+
+// labelLine renders a label on a single line.
+//
+// Parameters:
+//   - arrow: The prefix of the line.
+//   - label: The label to render.
+//
+// Returns:
+//   - string: The rendered label.
+func labelLine(arrow string, label Label) string {
+	line := arrow + " " + label.Span.String()
+
+	if label.Message != "" {
+		line += ": " + label.Message
+	}
+
+	return line
+}
+
+// At locates a fault in a source.
+//
+// Parameters:
+//   - fault: The fault to locate.
+//   - span: The location of the fault.
+//   - label: The message of the primary label. May be empty.
+//
+// Returns:
+//   - *Diagnostic: The located fault. Nil if fault is nil.
+func At(fault flt.Fault, span Span, label string) *Diagnostic {
+	if fault == nil {
+		return nil
+	}
+
+	return &Diagnostic{
+		Fault: fault,
+		Primary: Label{
+			Span:    span,
+			Message: label,
+		},
+	}
+}
+
+// Also adds a secondary label to the diagnostic.
+//
+// Parameters:
+//   - span: The related location.
+//   - label: The message of the label. May be empty.
+//
+// Returns:
+//   - *Diagnostic: The diagnostic; for chaining. Nil if the receiver is nil.
+func (d *Diagnostic) Also(span Span, label string) *Diagnostic {
+	if d == nil {
+		return nil
+	}
+
+	d.Secondary = append(d.Secondary, Label{
+		Span:    span,
+		Message: label,
+	})
+
+	return d
+}
+
+// Labels returns all the labels of the diagnostic.
+//
+// Returns:
+//   - []Label: The primary label followed by the secondary labels.
+func (d Diagnostic) Labels() []Label {
+	labels := make([]Label, 0, 1+len(d.Secondary))
+
+	labels = append(labels, d.Primary)
+	labels = append(labels, d.Secondary...)
+
+	return labels
+}
+
+// SpanOfFault returns the primary span of the fault; if it, or any fault it wraps, is a
+// Diagnostic.
+//
+// Parameters:
+//   - fault: The fault.
+//
+// Returns:
+//   - Span: The primary span.
+//   - bool: True if the fault has a span, false otherwise.
+func SpanOfFault(fault flt.Fault) (Span, bool) {
+	var d *Diagnostic
+
+	if !faults.As(fault, &d) {
+		return Span{}, false
+	}
+
+	return d.Primary.Span, true
+}
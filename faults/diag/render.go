@@ -0,0 +1,392 @@
+package diag
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+
+	flt "github.com/PlayerR9/go-fault"
+	"github.com/PlayerR9/go-fault/faults"
+)
+
+// Sources gives the content of the source files the spans refer to.
+type Sources interface {
+	// Source returns the content of a source file.
+	//
+	// Parameters:
+	//   - file: The name of the source file. (See Span.File)
+	//
+	// Returns:
+	//   - []byte: The content of the file.
+	//   - bool: True if the file is known, false otherwise.
+	Source(file string) ([]byte, bool)
+}
+
+// SourceMap is a Sources that maps the names of the files to their content. It is meant
+// for sources that are not on disk; such as the standard input or tests.
+type SourceMap map[string][]byte
+
+// Source implements the Sources interface.
+func (m SourceMap) Source(file string) ([]byte, bool) {
+	src, ok := m[file]
+	return src, ok
+}
+
+// fileSources reads the source files from the disk.
+type fileSources struct {
+	// mu guards cache.
+	mu sync.Mutex
+
+	// cache are the files that were already read. Nil content if the file could not be
+	// read.
+	cache map[string][]byte
+}
+
+// FileSources returns a Sources that reads the source files from the disk; the name of
+// a file being its path. Each file is read at most once.
+//
+// Returns:
+//   - Sources: The sources. Never returns nil.
+func FileSources() Sources {
+	return &fileSources{
+		cache: make(map[string][]byte),
+	}
+}
+
+// Source implements the Sources interface.
+func (fs *fileSources) Source(file string) ([]byte, bool) {
+	fs.mu.Lock()
+	defer fs.mu.Unlock()
+
+	src, ok := fs.cache[file]
+	if !ok {
+		src, _ = os.ReadFile(file)
+		fs.cache[file] = src
+	}
+
+	return src, src != nil
+}
+
+const (
+	// tabWidth is the number of columns a tab is rendered with.
+	tabWidth int = 4
+)
+
+// Render writes the fault as a compiler would; with the source lines of its labels
+// underlined. The primary label is underlined with carets (^) and the secondary labels
+// with dashes (-). Faults that are not located (see At) are written as faults.LinesOf
+// does.
+//
+// Parameters:
+//   - w: The writer to write to.
+//   - fault: The fault to render.
+//   - sources: The sources of the files the spans refer to. If nil, if a file is unknown
+//     or if a label of the file is outside of its source, the labels of that file are
+//     rendered without their source lines.
+//
+// Returns:
+//   - flt.Fault: The fault that occurred while writing, if any.
+func Render(w io.Writer, fault flt.Fault, sources Sources) flt.Fault {
+	for _, line := range Lines(fault, sources) {
+		_, err := io.WriteString(w, line+"\n")
+		if err != nil {
+			return faults.FromErrWithMsg(err, "could not write the diagnostic")
+		}
+	}
+
+	return nil
+}
+
+// Lines is like Render but returns the lines instead of writing them.
+//
+// Parameters:
+//   - fault: The fault to render.
+//   - sources: The sources of the files the spans refer to. May be nil.
+//
+// Returns:
+//   - []string: The rendered lines. Nil if the fault is nil.
+func Lines(fault flt.Fault, sources Sources) []string {
+	if fault == nil {
+		return nil
+	}
+
+	var d *Diagnostic
+
+	if !faults.As(fault, &d) {
+		return faults.LinesOf(fault)
+	}
+
+	desc := faults.DescriptorOf(fault)
+
+	lines := []string{
+		fmt.Sprintf("%s[%s]: %s", Severity(desc.Level()), desc.Code(), faults.MessageOf(fault)),
+	}
+
+	// Labels are grouped per file; the file of the primary label first.
+	var files []string
+
+	groups := make(map[string][]indexedLabel)
+
+	for i, label := range d.Labels() {
+		file := label.Span.File
+
+		_, ok := groups[file]
+		if !ok {
+			files = append(files, file)
+		}
+
+		groups[file] = append(groups[file], indexedLabel{Label: label, primary: i == 0})
+	}
+
+	for i, file := range files {
+		arrow := ":::"
+		if i == 0 {
+			arrow = "-->"
+		}
+
+		lines = append(lines, renderFile(arrow, groups[file], sources)...)
+	}
+
+	base, ok := faults.Access[*flt.BaseFault](fault)
+	if ok {
+		for _, suggestion := range base.Suggestions() {
+			lines = append(lines, "  = help: "+suggestion)
+		}
+	}
+
+	return lines
+}
+
+// indexedLabel is a label with its role.
+type indexedLabel struct {
+	Label
+
+	// primary tells whether the label is the primary label.
+	primary bool
+}
+
+// renderFile renders the labels of a single file.
+//
+// Parameters:
+//   - arrow: The arrow that introduces the file. ("-->" or ":::")
+//   - labels: The labels of the file. Not empty.
+//   - sources: The sources. May be nil.
+//
+// Returns:
+//   - []string: The rendered lines.
+func renderFile(arrow string, labels []indexedLabel, sources Sources) []string {
+	var src []byte
+
+	ok := false
+
+	if sources != nil {
+		src, ok = sources.Source(labels[0].Span.File)
+	}
+
+	var source_lines []string
+
+	if ok {
+		source_lines = strings.Split(string(bytes.ReplaceAll(src, []byte("\r\n"), []byte("\n"))), "\n")
+	}
+
+	if !ok || !within(labels, len(source_lines)) {
+		lines := make([]string, 0, len(labels))
+
+		for _, label := range labels {
+			lines = append(lines, " "+labelLine(arrow, label.Label))
+			arrow = ":::"
+		}
+
+		return lines
+	}
+
+	// The lines to show, in order.
+	var numbers []int
+
+	for _, label := range labels {
+		numbers = append(numbers, label.Span.StartLine)
+
+		if label.Span.IsMultiline() {
+			numbers = append(numbers, label.Span.EndLine)
+		}
+	}
+
+	slices.Sort(numbers)
+	numbers = slices.Compact(numbers)
+
+	width := len(strconv.Itoa(numbers[len(numbers)-1]))
+	gutter := strings.Repeat(" ", width) + " |"
+
+	lines := []string{
+		strings.Repeat(" ", width) + arrow + " " + labels[0].Span.String(),
+		gutter,
+	}
+
+	for i, number := range numbers {
+		if i > 0 && number > numbers[i-1]+1 {
+			lines = append(lines, "...")
+		}
+
+		text := source_lines[number-1]
+
+		lines = append(lines, fmt.Sprintf("%*d | %s", width, number, expandTabs(text)))
+
+		for _, label := range labelsOn(labels, number) {
+			lines = append(lines, gutter+" "+underline(text, number, label))
+		}
+	}
+
+	return lines
+}
+
+// within checks whether the labels only refer to lines of the source.
+//
+// Parameters:
+//   - labels: The labels.
+//   - count: The number of lines of the source.
+//
+// Returns:
+//   - bool: True if the spans of the labels are valid and their lines are within the
+//     source, false otherwise.
+func within(labels []indexedLabel, count int) bool {
+	for _, label := range labels {
+		if !label.Span.IsValid() || label.Span.StartLine > count {
+			return false
+		}
+
+		if label.Span.IsMultiline() && label.Span.EndLine > count {
+			return false
+		}
+	}
+
+	return true
+}
+
+// labelsOn returns the labels that start or end on the line, sorted by column.
+//
+// Parameters:
+//   - labels: The labels.
+//   - number: The line number.
+//
+// Returns:
+//   - []indexedLabel: The labels on the line.
+func labelsOn(labels []indexedLabel, number int) []indexedLabel {
+	var on []indexedLabel
+
+	for _, label := range labels {
+		if label.Span.StartLine == number || (label.Span.IsMultiline() && label.Span.EndLine == number) {
+			on = append(on, label)
+		}
+	}
+
+	slices.SortStableFunc(on, func(a, b indexedLabel) int {
+		return colOn(a, number) - colOn(b, number)
+	})
+
+	return on
+}
+
+// colOn returns the column at which the label's underline starts on the line.
+//
+// Parameters:
+//   - label: The label.
+//   - number: The line number.
+//
+// Returns:
+//   - int: The column.
+func colOn(label indexedLabel, number int) int {
+	if label.Span.StartLine == number {
+		return label.Span.StartCol
+	}
+
+	return 1
+}
+
+// underline renders the underline of a label on a line.
+//
+// Parameters:
+//   - text: The source line.
+//   - number: The line number.
+//   - label: The label.
+//
+// Returns:
+//   - string: The underline; followed by the message of the label if the label ends on
+//     the line.
+func underline(text string, number int, label indexedLabel) string {
+	marker := "-"
+	if label.primary {
+		marker = "^"
+	}
+
+	span := label.Span
+	line_len := len([]rune(text)) + 1
+
+	var start, end int
+
+	switch {
+	case !span.IsMultiline():
+		start, end = span.StartCol, span.EndCol
+	case span.StartLine == number:
+		start, end = span.StartCol, line_len
+	default:
+		start = 1 + len([]rune(text)) - len([]rune(strings.TrimLeft(text, " \t")))
+		end = span.EndCol
+	}
+
+	from := displayCol(text, start)
+
+	to := displayCol(text, end)
+	if to <= from {
+		to = from + 1
+	}
+
+	line := strings.Repeat(" ", from-1) + strings.Repeat(marker, to-from)
+
+	ends_here := !span.IsMultiline() || span.EndLine == number
+	if ends_here && label.Message != "" {
+		line += " " + label.Message
+	}
+
+	return line
+}
+
+// displayCol converts a column of a line to the column it is displayed at; tabs being
+// expanded.
+//
+// Parameters:
+//   - text: The line.
+//   - col: The column. Columns past the end of the line count one each.
+//
+// Returns:
+//   - int: The display column.
+func displayCol(text string, col int) int {
+	display := 1
+
+	runes := []rune(text)
+
+	for i := 0; i < col-1; i++ {
+		if i < len(runes) && runes[i] == '\t' {
+			display += tabWidth
+		} else {
+			display++
+		}
+	}
+
+	return display
+}
+
+// expandTabs replaces the tabs of the line with spaces. (See displayCol.)
+//
+// Parameters:
+//   - text: The line.
+//
+// Returns:
+//   - string: The line without tabs.
+func expandTabs(text string) string {
+	return strings.ReplaceAll(text, "\t", strings.Repeat(" ", tabWidth))
+}
@@ -0,0 +1,160 @@
+package diag_test
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	flt "github.com/PlayerR9/go-fault"
+	"github.com/PlayerR9/go-fault/faults"
+	"github.com/PlayerR9/go-fault/faults/diag"
+)
+
+func TestLines(t *testing.T) {
+	src := []byte("fn main() {\n    let x = ;\n}\n")
+
+	sources := diag.SourceMap{
+		"main.sd": src,
+		"tab.sd":  []byte("\tlet x = ;\r\n"),
+	}
+
+	fn := diag.SpanOf("main.sd", src, 0, 9)
+	tok := diag.SpanOf("main.sd", src, 24, 25)
+
+	tests := []struct {
+		name    string
+		fault   flt.Fault
+		sources diag.Sources
+		want    []string
+	}{
+		{
+			name:    "nil",
+			fault:   nil,
+			sources: sources,
+			want:    nil,
+		},
+		{
+			name:    "primary and secondary labels",
+			fault:   diag.At(faults.NewBadParameter("unexpected token"), tok, "expected an expression").Also(fn, "in this function"),
+			sources: sources,
+			want: []string{
+				"error[BadParameter]: unexpected token",
+				" --> main.sd:2:13",
+				"  |",
+				"1 | fn main() {",
+				"  | --------- in this function",
+				"2 |     let x = ;",
+				"  |             ^ expected an expression",
+			},
+		},
+		{
+			name:    "multiline span",
+			fault:   diag.At(faults.NewBadParameter("unclosed block"), diag.SpanOf("main.sd", src, 10, 27), "this block"),
+			sources: sources,
+			want: []string{
+				"error[BadParameter]: unclosed block",
+				" --> main.sd:1:11",
+				"  |",
+				"1 | fn main() {",
+				"  |           ^",
+				"...",
+				"3 | }",
+				"  | ^ this block",
+			},
+		},
+		{
+			name:    "tabs",
+			fault:   diag.At(faults.NewBadParameter("unexpected token"), diag.Span{File: "tab.sd", StartLine: 1, StartCol: 10, EndLine: 1, EndCol: 11}, ""),
+			sources: sources,
+			want: []string{
+				"error[BadParameter]: unexpected token",
+				" --> tab.sd:1:10",
+				"  |",
+				"1 |     let x = ;",
+				"  |             ^",
+			},
+		},
+		{
+			name:    "no sources",
+			fault:   diag.At(faults.NewBadParameter("unexpected token"), tok, "expected an expression").Also(fn, "in this function"),
+			sources: nil,
+			want: []string{
+				"error[BadParameter]: unexpected token",
+				" --> main.sd:2:13: expected an expression",
+				" ::: main.sd:1:1: in this function",
+			},
+		},
+		{
+			name:    "unknown file",
+			fault:   diag.At(faults.NewBadParameter("unexpected token"), diag.Span{File: "other.sd", StartLine: 1, StartCol: 1}, "here"),
+			sources: sources,
+			want: []string{
+				"error[BadParameter]: unexpected token",
+				" --> other.sd:1:1: here",
+			},
+		},
+		{
+			name:    "line past the end of the source",
+			fault:   diag.At(faults.NewBadParameter("unexpected token"), diag.Span{File: "main.sd", StartLine: 9, StartCol: 1, EndLine: 9, EndCol: 2}, "here"),
+			sources: sources,
+			want: []string{
+				"error[BadParameter]: unexpected token",
+				" --> main.sd:9:1: here",
+			},
+		},
+		{
+			name:    "secondary label past the end of the source",
+			fault:   diag.At(faults.NewBadParameter("unexpected token"), tok, "").Also(diag.Span{File: "main.sd", StartLine: 2, StartCol: 1, EndLine: 7, EndCol: 1}, "in this block"),
+			sources: sources,
+			want: []string{
+				"error[BadParameter]: unexpected token",
+				" --> main.sd:2:13",
+				" ::: main.sd:2:1: in this block",
+			},
+		},
+		{
+			name: "labels of other files",
+			fault: diag.At(faults.NewBadParameter("unexpected token"), tok, "").
+				Also(diag.Span{File: "other.sd", StartLine: 3, StartCol: 1}, "declared here"),
+			sources: sources,
+			want: []string{
+				"error[BadParameter]: unexpected token",
+				" --> main.sd:2:13",
+				"  |",
+				"2 |     let x = ;",
+				"  |             ^",
+				" ::: other.sd:3:1: declared here",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := diag.Lines(tt.fault, tt.sources)
+
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("got:\n%s\nwant:\n%s", strings.Join(got, "\n"), strings.Join(tt.want, "\n"))
+			}
+		})
+	}
+}
+
+func TestLinesSuggestions(t *testing.T) {
+	fault := faults.NewInvalidUsage("the journal is closed", "Open a new journal")
+
+	got := diag.Lines(diag.At(fault, diag.Span{File: "main.sd", StartLine: 1, StartCol: 1}, ""), nil)
+
+	if got[len(got)-1] != "  = help: Open a new journal" {
+		t.Errorf("got %q, want the suggestion last", got)
+	}
+}
+
+func TestLinesUnlocated(t *testing.T) {
+	fault := faults.NewBadParameter("x must be positive")
+
+	got := diag.Lines(fault, nil)
+
+	if !slices.Equal(got, faults.LinesOf(fault)) {
+		t.Errorf("got %q, want the lines of faults.LinesOf", got)
+	}
+}
@@ -0,0 +1,388 @@
+package diag
+
+import (
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+
+	flt "github.com/PlayerR9/go-fault"
+	"github.com/PlayerR9/go-fault/faults"
+)
+
+// Severity returns the severity, as named by compilers, of a level:
+//   - "fatal error" for FATAL and more severe levels;
+//   - "error" for ERROR and the levels between ERROR and FATAL;
+//   - "warning" for WARNING and the levels between WARNING and ERROR;
+//   - "note" for the less severe levels; such as NOTICE and DEBUG.
+//
+// Parameters:
+//   - level: The level.
+//
+// Returns:
+//   - string: The severity.
+func Severity(level flt.FaultLevel) string {
+	switch {
+	case level.AtLeast(flt.FATAL):
+		return "fatal error"
+	case level.AtLeast(flt.ERROR):
+		return "error"
+	case level.AtLeast(flt.WARNING):
+		return "warning"
+	default:
+		return "note"
+	}
+}
+
+// entry is a fault of a DiagnosticSet.
+type entry struct {
+	// fault is the fault.
+	fault flt.Fault
+
+	// diag is the located fault. Nil if the fault is not located.
+	diag *Diagnostic
+
+	// span is the primary span. The zero span if the fault is not located.
+	span Span
+
+	// level is the level of the fault.
+	level flt.FaultLevel
+}
+
+// DiagnosticSet collects the faults of a run, such as a compilation, and reports them
+// sorted by file and position. It is safe for concurrent use.
+type DiagnosticSet struct {
+	// mu guards entries.
+	mu sync.Mutex
+
+	// entries are the faults, in order of addition.
+	entries []entry
+
+	// max_per_file is the maximum number of faults reported per file.
+	max_per_file int
+}
+
+// NewDiagnosticSet creates a new, empty, DiagnosticSet.
+//
+// Parameters:
+//   - max_per_file: The maximum number of located faults reported per file; the others
+//     being only counted. If not positive, all the faults are reported.
+//
+// Returns:
+//   - *DiagnosticSet: The new DiagnosticSet. Never returns nil.
+func NewDiagnosticSet(max_per_file int) *DiagnosticSet {
+	return &DiagnosticSet{
+		max_per_file: max_per_file,
+	}
+}
+
+// Add adds the faults to the set. Joined faults (see faults.Join) are added one by one.
+// Faults that are not located (see At) are reported before the others and are not
+// subject to the cap of NewDiagnosticSet.
+//
+// Parameters:
+//   - faults: The faults to add. Nil faults are ignored.
+func (s *DiagnosticSet) Add(elems ...flt.Fault) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, fault := range elems {
+		s.add(fault)
+	}
+}
+
+// add adds a fault to the set. The lock must be held.
+//
+// Parameters:
+//   - fault: The fault to add. Ignored if nil.
+func (s *DiagnosticSet) add(fault flt.Fault) {
+	if fault == nil {
+		return
+	}
+
+	jf, ok := fault.(*faults.JoinFault)
+	if ok {
+		for _, inner := range jf.Faults() {
+			s.add(inner)
+		}
+
+		return
+	}
+
+	e := entry{
+		fault: fault,
+		level: faults.LevelOf(fault),
+	}
+
+	if faults.As(fault, &e.diag) {
+		e.span = e.diag.Primary.Span
+	}
+
+	s.entries = append(s.entries, e)
+}
+
+// Len returns the number of faults added to the set.
+//
+// Returns:
+//   - int: The number of faults.
+func (s *DiagnosticSet) Len() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return len(s.entries)
+}
+
+// report returns the faults to report and the number of faults that are omitted.
+//
+// The faults are sorted by file and position. Of the faults whose primary spans overlap,
+// directly or through other faults, only the most severe is kept; if they are equally
+// severe, the one whose span is within the other's or, otherwise, the first one. Then, at
+// most max_per_file faults are kept per file; the faults that are not located are always
+// kept.
+//
+// Returns:
+//   - []entry: The faults to report.
+//   - int: The number of faults that were capped.
+func (s *DiagnosticSet) report() ([]entry, int) {
+	s.mu.Lock()
+	entries := slices.Clone(s.entries)
+	s.mu.Unlock()
+
+	slices.SortStableFunc(entries, func(a, b entry) int {
+		return a.span.Compare(b.span)
+	})
+
+	var kept []entry
+
+	// group holds the faults whose spans overlap, directly or through other faults, and
+	// that are yet to be reduced to the preferred one. As the faults are sorted, a fault
+	// that overlaps none of them overlaps none of the faults that follow either.
+	var group []entry
+
+	flush := func() {
+		if len(group) == 0 {
+			return
+		}
+
+		best := group[0]
+
+		for _, other := range group[1:] {
+			if prefer(other, best) {
+				best = other
+			}
+		}
+
+		kept = append(kept, best)
+		group = group[:0]
+	}
+
+	for _, e := range entries {
+		if e.diag == nil {
+			kept = append(kept, e)
+
+			continue
+		}
+
+		ok := slices.ContainsFunc(group, func(g entry) bool {
+			return g.span.Overlaps(e.span)
+		})
+
+		if !ok {
+			flush()
+		}
+
+		group = append(group, e)
+	}
+
+	flush()
+
+	if s.max_per_file <= 0 {
+		return kept, 0
+	}
+
+	var capped int
+
+	per_file := make(map[string]int)
+
+	reported := kept[:0]
+
+	for _, e := range kept {
+		if e.diag == nil {
+			reported = append(reported, e)
+
+			continue
+		}
+
+		if per_file[e.span.File] >= s.max_per_file {
+			capped++
+
+			continue
+		}
+
+		per_file[e.span.File]++
+
+		reported = append(reported, e)
+	}
+
+	return reported, capped
+}
+
+// prefer tells whether a fault is preferred over another one of its group that comes
+// before it: it is if it is more severe or, if they are equally severe, if its span is
+// within the other's.
+//
+// Parameters:
+//   - e: The fault.
+//   - other: The other fault.
+//
+// Returns:
+//   - bool: True if e is preferred over other, false otherwise.
+func prefer(e, other entry) bool {
+	c := e.level.Compare(other.level)
+
+	return c > 0 || (c == 0 && other.span.contains(e.span))
+}
+
+// Diagnostics returns the faults to report; sorted, deduplicated and capped. (See Add.)
+//
+// Returns:
+//   - []flt.Fault: The faults to report.
+func (s *DiagnosticSet) Diagnostics() []flt.Fault {
+	entries, _ := s.report()
+
+	elems := make([]flt.Fault, 0, len(entries))
+
+	for _, e := range entries {
+		elems = append(elems, e.fault)
+	}
+
+	return elems
+}
+
+// WriteGCC writes the faults to report in the format of GCC; which editors understand
+// (e.g., Vim's errorformat) to jump to the locations:
+//
+//	"<file>:<line>:<column>: <severity>: <message>"
+//
+// The secondary labels of a fault follow it as notes. Faults that are not located are
+// written as "<severity>: <message>". (See Severity.)
+//
+// Parameters:
+//   - w: The writer to write to.
+//
+// Returns:
+//   - flt.Fault: The fault that occurred while writing, if any.
+func (s *DiagnosticSet) WriteGCC(w io.Writer) flt.Fault {
+	entries, _ := s.report()
+
+	var builder strings.Builder
+
+	for _, e := range entries {
+		if e.diag == nil {
+			fmt.Fprintf(&builder, "%s: %s\n", Severity(e.level), faults.MessageOf(e.fault))
+
+			continue
+		}
+
+		fmt.Fprintf(&builder, "%s: %s: %s\n", e.span, Severity(e.level), gccMessage(e.fault, e.diag.Primary))
+
+		for _, label := range e.diag.Secondary {
+			fmt.Fprintf(&builder, "%s: note: %s\n", label.Span, label.Message)
+		}
+	}
+
+	_, err := io.WriteString(w, builder.String())
+	if err != nil {
+		return faults.FromErrWithMsg(err, "could not write the diagnostics")
+	}
+
+	return nil
+}
+
+// gccMessage returns the message of a located fault; followed by its primary label, if
+// any.
+//
+// Parameters:
+//   - fault: The fault.
+//   - primary: The primary label of the fault.
+//
+// Returns:
+//   - string: The message.
+func gccMessage(fault flt.Fault, primary Label) string {
+	msg := faults.MessageOf(fault)
+
+	if primary.Message != "" {
+		msg += ": " + primary.Message
+	}
+
+	return msg
+}
+
+// Summary returns the number of faults per severity. (e.g., "3 errors, 2 warnings")
+//
+// Every added fault is counted; including the ones that are not reported because of
+// duplicates or of the cap. The number of the latter is given in parentheses.
+//
+// Returns:
+//   - string: The summary. "no diagnostics" if the set is empty.
+func (s *DiagnosticSet) Summary() string {
+	_, capped := s.report()
+
+	s.mu.Lock()
+
+	counts := make(map[string]int)
+
+	for _, e := range s.entries {
+		counts[Severity(e.level)]++
+	}
+
+	s.mu.Unlock()
+
+	var parts []string
+
+	for _, severity := range [...]string{"fatal error", "error", "warning", "note"} {
+		n := counts[severity]
+		if n == 0 {
+			continue
+		}
+
+		part := strconv.Itoa(n) + " " + severity
+		if n > 1 {
+			part += "s"
+		}
+
+		parts = append(parts, part)
+	}
+
+	if len(parts) == 0 {
+		return "no diagnostics"
+	}
+
+	summary := strings.Join(parts, ", ")
+
+	if capped > 0 {
+		summary += " (" + strconv.Itoa(capped) + " not shown)"
+	}
+
+	return summary
+}
+
+// HasErrors checks whether the set has at least one fault of severity "error" or "fatal
+// error".
+//
+// Returns:
+//   - bool: True if the set has errors, false otherwise.
+func (s *DiagnosticSet) HasErrors() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, e := range s.entries {
+		if e.level.AtLeast(flt.ERROR) {
+			return true
+		}
+	}
+
+	return false
+}
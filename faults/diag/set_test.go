@@ -0,0 +1,175 @@
+package diag_test
+
+import (
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+
+	flt "github.com/PlayerR9/go-fault"
+	"github.com/PlayerR9/go-fault/faults"
+	"github.com/PlayerR9/go-fault/faults/diag"
+)
+
+// span returns the span of the columns [start, end) of a line of a file.
+func span(file string, line, start, end int) diag.Span {
+	return diag.Span{File: file, StartLine: line, StartCol: start, EndLine: line, EndCol: end}
+}
+
+// located returns a fault of the level located at the span; its message being msg.
+func located(level flt.FaultLevel, msg string, s diag.Span) flt.Fault {
+	return diag.At(flt.NewDescriptor(level, flt.BadParameter, msg).Init(), s, "")
+}
+
+func TestDiagnosticSet(t *testing.T) {
+	unlocated := func(msg string) flt.Fault {
+		return faults.NewBadParameter(msg)
+	}
+
+	tests := []struct {
+		name   string
+		max    int
+		faults []flt.Fault
+		want   []string
+		capped int
+	}{
+		{
+			name: "sorted by file and position",
+			faults: []flt.Fault{
+				located(flt.ERROR, "b2", span("b.sd", 1, 1, 2)),
+				located(flt.ERROR, "a2", span("a.sd", 2, 1, 2)),
+				located(flt.ERROR, "a1", span("a.sd", 1, 5, 6)),
+				unlocated("u"),
+			},
+			want: []string{"u", "a1", "a2", "b2"},
+		},
+		{
+			name: "the most severe of overlapping spans",
+			faults: []flt.Fault{
+				located(flt.WARNING, "warning", span("a.sd", 1, 1, 10)),
+				located(flt.ERROR, "error", span("a.sd", 1, 5, 6)),
+			},
+			want: []string{"error"},
+		},
+		{
+			name: "the narrower of equally severe spans",
+			faults: []flt.Fault{
+				located(flt.ERROR, "wide", span("a.sd", 1, 1, 10)),
+				located(flt.ERROR, "narrow", span("a.sd", 1, 5, 6)),
+			},
+			want: []string{"narrow"},
+		},
+		{
+			name: "the narrower of equally severe spans at the same start",
+			faults: []flt.Fault{
+				located(flt.ERROR, "wide", span("a.sd", 1, 1, 10)),
+				located(flt.ERROR, "narrow", span("a.sd", 1, 1, 2)),
+			},
+			want: []string{"narrow"},
+		},
+		{
+			name: "the first of equally severe crossing spans",
+			faults: []flt.Fault{
+				located(flt.ERROR, "second", span("a.sd", 1, 3, 10)),
+				located(flt.ERROR, "first", span("a.sd", 1, 1, 5)),
+			},
+			want: []string{"first"},
+		},
+		{
+			name: "a more severe wide span",
+			faults: []flt.Fault{
+				located(flt.ERROR, "wide", span("a.sd", 1, 1, 10)),
+				located(flt.WARNING, "narrow", span("a.sd", 1, 5, 6)),
+			},
+			want: []string{"wide"},
+		},
+		{
+			name: "spans that overlap through a dropped span",
+			faults: []flt.Fault{
+				located(flt.ERROR, "wide", span("a.sd", 1, 1, 10)),
+				located(flt.FATAL, "left", span("a.sd", 1, 2, 3)),
+				located(flt.ERROR, "right", span("a.sd", 1, 5, 6)),
+			},
+			want: []string{"left"},
+		},
+		{
+			name: "a chain of equally severe crossing spans",
+			faults: []flt.Fault{
+				located(flt.WARNING, "left", span("a.sd", 1, 1, 3)),
+				located(flt.WARNING, "across", span("a.sd", 1, 2, 6)),
+				located(flt.WARNING, "right", span("a.sd", 1, 5, 7)),
+			},
+			want: []string{"left"},
+		},
+		{
+			name: "spans that touch",
+			faults: []flt.Fault{
+				located(flt.WARNING, "left", span("a.sd", 1, 1, 3)),
+				located(flt.WARNING, "right", span("a.sd", 1, 3, 5)),
+			},
+			want: []string{"left", "right"},
+		},
+		{
+			name: "capped per file",
+			max:  1,
+			faults: []flt.Fault{
+				located(flt.ERROR, "a1", span("a.sd", 1, 1, 2)),
+				located(flt.ERROR, "a2", span("a.sd", 2, 1, 2)),
+				located(flt.ERROR, "b1", span("b.sd", 1, 1, 2)),
+			},
+			want:   []string{"a1", "b1"},
+			capped: 1,
+		},
+		{
+			name: "unlocated faults are not capped",
+			max:  1,
+			faults: []flt.Fault{
+				unlocated("u1"),
+				unlocated("u2"),
+				located(flt.ERROR, "a1", span("a.sd", 1, 1, 2)),
+				located(flt.ERROR, "a2", span("a.sd", 2, 1, 2)),
+			},
+			want:   []string{"u1", "u2", "a1"},
+			capped: 1,
+		},
+		{
+			name:   "joined faults",
+			faults: []flt.Fault{faults.Join(unlocated("u1"), unlocated("u2"))},
+			want:   []string{"u1", "u2"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := diag.NewDiagnosticSet(tt.max)
+			s.Add(tt.faults...)
+
+			var got []string
+
+			for _, fault := range s.Diagnostics() {
+				got = append(got, faults.MessageOf(fault))
+			}
+
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+
+			got_capped := summaryCapped(s.Summary())
+			if got_capped != tt.capped {
+				t.Errorf("Summary: got %d not shown, want %d: %s", got_capped, tt.capped, s.Summary())
+			}
+		})
+	}
+}
+
+// summaryCapped returns the number of faults not shown according to a summary.
+func summaryCapped(summary string) int {
+	_, tail, ok := strings.Cut(summary, " (")
+	if !ok {
+		return 0
+	}
+
+	n, _ := strconv.Atoi(strings.TrimSuffix(tail, " not shown)"))
+
+	return n
+}
@@ -0,0 +1,257 @@
+package diag
+
+import (
+	"strconv"
+	"unicode/utf8"
+)
+
+// Span is a range of a source file. Lines and columns start at 1; columns count runes.
+// The end is exclusive: a span whose end is its start is empty and designates a position
+// between two characters.
+type Span struct {
+	// File is the name of the source file. (e.g., "main.sd")
+	File string
+
+	// StartLine is the line of the first character of the span.
+	StartLine int
+
+	// StartCol is the column of the first character of the span.
+	StartCol int
+
+	// EndLine is the line of the end of the span.
+	EndLine int
+
+	// EndCol is the column right after the last character of the span.
+	EndCol int
+
+	// Offset is the byte offset, in the source file, of the first character of the span.
+	Offset int
+}
+
+// String implements the fmt.Stringer interface.
+//
+// Format:
+//
+//	"<file>:<line>:<column>"
+//
+// The file is omitted if empty.
+func (s Span) String() string {
+	pos := strconv.Itoa(s.StartLine) + ":" + strconv.Itoa(s.StartCol)
+
+	if s.File == "" {
+		return pos
+	}
+
+	return s.File + ":" + pos
+}
+
+// IsValid checks whether the span designates a position of a source.
+//
+// Returns:
+//   - bool: True if the start of the span is a valid position, false otherwise.
+func (s Span) IsValid() bool {
+	return s.StartLine > 0 && s.StartCol > 0
+}
+
+// IsMultiline checks whether the span covers more than one line.
+//
+// Returns:
+//   - bool: True if the span covers more than one line, false otherwise.
+func (s Span) IsMultiline() bool {
+	return s.EndLine > s.StartLine
+}
+
+// Compare compares the starts, then the ends, of two spans of the same file. The files
+// are compared first otherwise.
+//
+// Parameters:
+//   - other: The span to compare with.
+//
+// Returns:
+//   - int: -1 if s comes before other, 1 if it comes after, 0 if they are at the same
+//     position.
+func (s Span) Compare(other Span) int {
+	pairs := [...][2]int{
+		{s.StartLine, other.StartLine},
+		{s.StartCol, other.StartCol},
+		{s.EndLine, other.EndLine},
+		{s.EndCol, other.EndCol},
+	}
+
+	switch {
+	case s.File < other.File:
+		return -1
+	case s.File > other.File:
+		return 1
+	}
+
+	for _, pair := range pairs {
+		switch {
+		case pair[0] < pair[1]:
+			return -1
+		case pair[0] > pair[1]:
+			return 1
+		}
+	}
+
+	return 0
+}
+
+// Overlaps checks whether two spans of the same file share at least one character. An
+// empty span overlaps the spans that contain its position and the empty spans at the same
+// position.
+//
+// Parameters:
+//   - other: The span to check against.
+//
+// Returns:
+//   - bool: True if the spans overlap, false otherwise.
+func (s Span) Overlaps(other Span) bool {
+	if s.File != other.File {
+		return false
+	}
+
+	s_start := pos{s.StartLine, s.StartCol}
+	o_start := pos{other.StartLine, other.StartCol}
+
+	s_end_line, s_end_col := s.end()
+	s_end := pos{s_end_line, s_end_col}
+
+	o_end_line, o_end_col := other.end()
+	o_end := pos{o_end_line, o_end_col}
+
+	switch {
+	case s.isEmpty() && other.isEmpty():
+		return s_start == o_start
+	case s.isEmpty():
+		return !s_start.before(o_start) && s_start.before(o_end)
+	case other.isEmpty():
+		return !o_start.before(s_start) && o_start.before(s_end)
+	default:
+		return s_start.before(o_end) && o_start.before(s_end)
+	}
+}
+
+// contains checks whether the other span is strictly within the span; that is, whether
+// it is narrower and neither starts before the span nor ends after it.
+//
+// Parameters:
+//   - other: The span to check.
+//
+// Returns:
+//   - bool: True if other is strictly within s, false otherwise.
+func (s Span) contains(other Span) bool {
+	if s.File != other.File || s == other {
+		return false
+	}
+
+	s_end_line, s_end_col := s.end()
+	o_end_line, o_end_col := other.end()
+
+	s_start, s_end := pos{s.StartLine, s.StartCol}, pos{s_end_line, s_end_col}
+	o_start, o_end := pos{other.StartLine, other.StartCol}, pos{o_end_line, o_end_col}
+
+	if o_start.before(s_start) || s_end.before(o_end) {
+		return false
+	}
+
+	return o_start != s_start || o_end != s_end
+}
+
+// pos is a position of a source file.
+type pos struct {
+	// line is the line of the position.
+	line int
+
+	// col is the column of the position.
+	col int
+}
+
+// before checks whether the position comes before the other.
+//
+// Parameters:
+//   - other: The position to compare with.
+//
+// Returns:
+//   - bool: True if p comes strictly before other, false otherwise.
+func (p pos) before(other pos) bool {
+	return p.line < other.line || (p.line == other.line && p.col < other.col)
+}
+
+// end returns the end of the span; its start if the end is unset.
+//
+// Returns:
+//   - int: The end line.
+//   - int: The end column.
+func (s Span) end() (int, int) {
+	if s.EndLine < s.StartLine || (s.EndLine == s.StartLine && s.EndCol < s.StartCol) {
+		return s.StartLine, s.StartCol
+	}
+
+	return s.EndLine, s.EndCol
+}
+
+// isEmpty checks whether the span has no character.
+//
+// Returns:
+//   - bool: True if the span is empty, false otherwise.
+func (s Span) isEmpty() bool {
+	line, col := s.end()
+
+	return line == s.StartLine && col == s.StartCol
+}
+
+// SpanOf returns the span of the bytes [start, end) of a source. It is meant for the
+// lexers and parsers that only track offsets.
+//
+// Parameters:
+//   - file: The name of the source file.
+//   - src: The content of the source file.
+//   - start: The byte offset of the first character of the span.
+//   - end: The byte offset right after the last character of the span. If less than
+//     start, start is used.
+//
+// Returns:
+//   - Span: The span. Offsets outside of the source are clamped.
+func SpanOf(file string, src []byte, start, end int) Span {
+	start = min(max(start, 0), len(src))
+	end = min(max(end, start), len(src))
+
+	span := Span{
+		File:   file,
+		Offset: start,
+	}
+
+	span.StartLine, span.StartCol = position(src, start)
+	span.EndLine, span.EndCol = position(src, end)
+
+	return span
+}
+
+// position returns the line and the column of a byte offset.
+//
+// Parameters:
+//   - src: The content of the source file.
+//   - offset: The byte offset. Assumed to be within [0, len(src)].
+//
+// Returns:
+//   - int: The line.
+//   - int: The column.
+func position(src []byte, offset int) (int, int) {
+	line, col := 1, 1
+
+	for i := 0; i < offset; {
+		r, size := utf8.DecodeRune(src[i:])
+
+		if r == '\n' {
+			line++
+			col = 1
+		} else {
+			col++
+		}
+
+		i += size
+	}
+
+	return line, col
+}
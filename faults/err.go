@@ -28,12 +28,17 @@ func (e ErrFault) Embeds() flt.Fault {
 // Where, <error> is the error that occurred. If no error was provided, "no error
 // provided" is used instead.
 func (e ErrFault) InfoLines() []string {
+	return e.InfoLinesIn(nil)
+}
+
+// InfoLinesIn implements the flt.LocalizedInfoer interface.
+func (e ErrFault) InfoLinesIn(tr flt.Translator) []string {
 	lines := make([]string, 0, 1)
 
 	if e.Err != nil {
-		lines = append(lines, "- Error: "+e.Err.Error())
+		lines = append(lines, "- "+translate(tr, "Error:")+" "+e.Err.Error())
 	} else {
-		lines = append(lines, "- Error: no error provided")
+		lines = append(lines, "- "+translate(tr, "Error:")+" "+translate(tr, "no error provided"))
 	}
 
 	return lines
@@ -47,12 +52,12 @@ func (e ErrFault) InfoLines() []string {
 // Returns:
 //   - *ErrFault: The new ErrFault. Never returns nil.
 func FromErr(err error) flt.Fault {
-	base := flt.New(flt.UnknownCode, "something went wrong")
+	base := flt.NewBase(flt.NewDescriptor(flt.ERROR, flt.UnknownCode, "something went wrong"))
 
-	return &ErrFault{
+	return apply(&ErrFault{
 		Fault: base,
 		Err:   err,
-	}
+	}, nil)
 }
 
 // ErrPanic is an error that indicates that a panic occurred.
@@ -74,9 +79,14 @@ func (e ErrPanic) Embeds() flt.Fault {
 //
 //	"- Value: <value>"
 func (e ErrPanic) InfoLines() []string {
+	return e.InfoLinesIn(nil)
+}
+
+// InfoLinesIn implements the flt.LocalizedInfoer interface.
+func (e ErrPanic) InfoLinesIn(tr flt.Translator) []string {
 	lines := make([]string, 0, 1)
 
-	lines = append(lines, "- Value: "+fmt.Sprintf("%v", e.Value))
+	lines = append(lines, "- "+translate(tr, "Value:")+" "+fmt.Sprintf("%v", e.Value))
 
 	return lines
 }
@@ -89,10 +99,28 @@ func (e ErrPanic) InfoLines() []string {
 // Returns:
 //   - *ErrPanic: A new ErrPanic. Never returns nil.
 func NewErrPanic(value any) *ErrPanic {
-	base := flt.WithLevel(flt.FATAL, flt.UnknownCode, "a panic occurred")
+	base := flt.NewBase(flt.NewDescriptor(flt.FATAL, flt.UnknownCode, "a panic occurred"))
 
-	return &ErrPanic{
+	return apply(&ErrPanic{
 		Fault: base,
 		Value: value,
-	}
+	}, nil)
+}
+
+// FromErrWithMsg is like FromErr but the fault has the OperationFailed code and the given
+// message; which describes the operation that failed.
+//
+// Parameters:
+//   - err: The error that occurred.
+//   - msg: The message of the fault.
+//
+// Returns:
+//   - *ErrFault: The new ErrFault. Never returns nil.
+func FromErrWithMsg(err error, msg string) flt.Fault {
+	base := flt.NewBase(flt.NewDescriptor(flt.ERROR, flt.OperationFailed, msg))
+
+	return apply(&ErrFault{
+		Fault: base,
+		Err:   err,
+	}, nil)
 }
@@ -0,0 +1,78 @@
+package faulttest
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	flt "github.com/PlayerR9/go-fault"
+)
+
+// FakeClock is a flt.Clock whose time only changes when told to. It is safe for
+// concurrent use.
+type FakeClock struct {
+	// mu guards now.
+	mu sync.Mutex
+
+	// now is the current time of the clock.
+	now time.Time
+}
+
+// NewFakeClock creates a new FakeClock.
+//
+// Parameters:
+//   - start: The initial time of the clock.
+//
+// Returns:
+//   - *FakeClock: The new FakeClock. Never returns nil.
+func NewFakeClock(start time.Time) *FakeClock {
+	return &FakeClock{
+		now: start,
+	}
+}
+
+// Now implements the flt.Clock interface.
+func (c *FakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.now
+}
+
+// Set sets the current time of the clock.
+//
+// Parameters:
+//   - now: The new current time.
+func (c *FakeClock) Set(now time.Time) {
+	c.mu.Lock()
+	c.now = now
+	c.mu.Unlock()
+}
+
+// Advance moves the clock forward.
+//
+// Parameters:
+//   - d: The duration to move the clock by.
+//
+// Returns:
+//   - time.Time: The new current time.
+func (c *FakeClock) Advance(d time.Duration) time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.now = c.now.Add(d)
+
+	return c.now
+}
+
+// UseClock sets the global clock for the duration of the test. (See flt.SetClock.) The
+// previous clock is restored when the test ends.
+//
+// Parameters:
+//   - tb: The test.
+//   - clock: The clock to use.
+func UseClock(tb testing.TB, clock flt.Clock) {
+	tb.Helper()
+
+	tb.Cleanup(flt.SetClock(clock))
+}
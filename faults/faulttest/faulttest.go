@@ -0,0 +1,372 @@
+// Package faulttest provides assertions for tests of code that returns faults. When an
+// assertion fails, the whole fault is shown; as rendered by faults.LinesOf.
+//
+// Example:
+//
+//	func TestPetOf(t *testing.T) {
+//		_, err := PetOf("Mark")
+//
+//		faulttest.AssertCode(t, err, fault.OperationFailed)
+//		faulttest.AssertHasKey(t, err, "key")
+//	}
+package faulttest
+
+import (
+	"cmp"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+	"testing"
+
+	flt "github.com/PlayerR9/go-fault"
+	"github.com/PlayerR9/go-fault/faults"
+)
+
+// render renders the fault for failure messages.
+//
+// Parameters:
+//   - fault: The fault to render.
+//
+// Returns:
+//   - string: The rendered fault, indented.
+func render(fault flt.Fault) string {
+	if fault == nil {
+		return "\t<nil>"
+	}
+
+	lines := faults.LinesOf(fault)
+
+	return "\t" + strings.Join(lines, "\n\t")
+}
+
+// AssertNil asserts that the fault is nil.
+//
+// Parameters:
+//   - tb: The test.
+//   - fault: The fault to check.
+//
+// Returns:
+//   - bool: True if the assertion holds, false otherwise.
+func AssertNil(tb testing.TB, fault flt.Fault) bool {
+	tb.Helper()
+
+	if fault == nil {
+		return true
+	}
+
+	tb.Errorf("expected no fault, got:\n%s", render(fault))
+
+	return false
+}
+
+// AssertNotNil asserts that the fault is not nil.
+//
+// Parameters:
+//   - tb: The test.
+//   - fault: The fault to check.
+//
+// Returns:
+//   - bool: True if the assertion holds, false otherwise.
+func AssertNotNil(tb testing.TB, fault flt.Fault) bool {
+	tb.Helper()
+
+	if fault != nil {
+		return true
+	}
+
+	tb.Errorf("expected a fault, got none")
+
+	return false
+}
+
+// assertBase asserts that the fault has a base. (See flt.BaseFault.)
+//
+// Parameters:
+//   - tb: The test.
+//   - fault: The fault to check.
+//
+// Returns:
+//   - *flt.BaseFault: The base of the fault. Nil if the assertion does not hold.
+//   - bool: True if the assertion holds, false otherwise.
+func assertBase(tb testing.TB, fault flt.Fault) (*flt.BaseFault, bool) {
+	tb.Helper()
+
+	if !AssertNotNil(tb, fault) {
+		return nil, false
+	}
+
+	base, ok := faults.Access[*flt.BaseFault](fault)
+	if ok {
+		return base, true
+	}
+
+	tb.Errorf("expected a fault with a base, got one of type %T", fault)
+
+	return nil, false
+}
+
+// AssertCode asserts that the fault has the given code. Faults restored from a record
+// (see faults.Record) match if the name and the type of their code match.
+//
+// Parameters:
+//   - tb: The test.
+//   - fault: The fault to check.
+//   - code: The expected code.
+//
+// Returns:
+//   - bool: True if the assertion holds, false otherwise.
+func AssertCode[C flt.FaultCode](tb testing.TB, fault flt.Fault, code C) bool {
+	tb.Helper()
+
+	if !AssertNotNil(tb, fault) {
+		return false
+	}
+
+	if faults.Code(code)(fault) {
+		return true
+	}
+
+	got := faults.DescriptorOf(fault).Code()
+
+	tb.Errorf("expected code %s, got %s in:\n%s", code.String(), got.String(), render(fault))
+
+	return false
+}
+
+// AssertLevel asserts that the fault has the given level.
+//
+// Parameters:
+//   - tb: The test.
+//   - fault: The fault to check.
+//   - level: The expected level.
+//
+// Returns:
+//   - bool: True if the assertion holds, false otherwise.
+func AssertLevel(tb testing.TB, fault flt.Fault, level flt.FaultLevel) bool {
+	tb.Helper()
+
+	if !AssertNotNil(tb, fault) {
+		return false
+	}
+
+	got := faults.LevelOf(fault)
+	if got == level {
+		return true
+	}
+
+	tb.Errorf("expected level %s, got %s in:\n%s", level.String(), got.String(), render(fault))
+
+	return false
+}
+
+// AssertHasKey asserts that the fault's context has the given key.
+//
+// Parameters:
+//   - tb: The test.
+//   - fault: The fault to check.
+//   - key: The expected key.
+//
+// Returns:
+//   - bool: True if the assertion holds, false otherwise.
+func AssertHasKey(tb testing.TB, fault flt.Fault, key string) bool {
+	tb.Helper()
+
+	base, ok := assertBase(tb, fault)
+	if !ok {
+		return false
+	}
+
+	_, ok = base.Value(key)
+	if ok {
+		return true
+	}
+
+	tb.Errorf("expected key %q in the context of:\n%s", key, render(fault))
+
+	return false
+}
+
+// AssertSuggestion asserts that at least one of the fault's suggestions contains the
+// given text.
+//
+// Parameters:
+//   - tb: The test.
+//   - fault: The fault to check.
+//   - text: The expected text.
+//
+// Returns:
+//   - bool: True if the assertion holds, false otherwise.
+func AssertSuggestion(tb testing.TB, fault flt.Fault, text string) bool {
+	tb.Helper()
+
+	base, ok := assertBase(tb, fault)
+	if !ok {
+		return false
+	}
+
+	for _, suggestion := range base.Suggestions() {
+		if strings.Contains(suggestion, text) {
+			return true
+		}
+	}
+
+	tb.Errorf("expected a suggestion containing %q in:\n%s", text, render(fault))
+
+	return false
+}
+
+// AssertJoinLen asserts that the fault is a join of exactly n faults. (See faults.Join.)
+//
+// Parameters:
+//   - tb: The test.
+//   - fault: The fault to check.
+//   - n: The expected number of joined faults.
+//
+// Returns:
+//   - bool: True if the assertion holds, false otherwise.
+func AssertJoinLen(tb testing.TB, fault flt.Fault, n int) bool {
+	tb.Helper()
+
+	if !AssertNotNil(tb, fault) {
+		return false
+	}
+
+	jf, ok := faults.Access[*faults.JoinFault](fault)
+	if !ok {
+		tb.Errorf("expected a join of %d faults, got a fault that is not a join:\n%s", n, render(fault))
+
+		return false
+	}
+
+	got := len(jf.Faults())
+	if got == n {
+		return true
+	}
+
+	tb.Errorf("expected a join of %d faults, got %d:\n%s", n, got, render(fault))
+
+	return false
+}
+
+const (
+	// UpdateEnv is the environment variable that, when set to a non-empty value, makes
+	// AssertGolden write the golden files instead of comparing against them.
+	UpdateEnv string = "FAULTTEST_UPDATE"
+)
+
+const (
+	// occurredAt is the label of the timestamps of the rendered faults.
+	occurredAt string = "Occurred at:"
+)
+
+// timestampRe returns the regular expression that matches the "Occurred at:" lines; in
+// English and in all the locales of faults.DefaultCatalog, indented or not. The label,
+// with its indentation, is the first group.
+//
+// Returns:
+//   - *regexp.Regexp: The regular expression. Never returns nil.
+func timestampRe() *regexp.Regexp {
+	labels := append([]string{occurredAt}, faults.DefaultCatalog.Translations(occurredAt)...)
+
+	// The longest labels come first so that no label is cut short by one of its prefixes.
+	slices.SortFunc(labels, func(a, b string) int {
+		return cmp.Compare(len(b), len(a))
+	})
+
+	for i, label := range labels {
+		labels[i] = regexp.QuoteMeta(label)
+	}
+
+	return regexp.MustCompile(`^(\s*(?:` + strings.Join(labels, "|") + `)) .*$`)
+}
+
+// Normalize replaces the parts of rendered lines that change from one run to another
+// (i.e., the timestamps) with placeholders; so that they can be compared against golden
+// files. The timestamps are recognized by their "Occurred at:" label; whether it is
+// translated by faults.DefaultCatalog or not, and even in the indented lines of joined
+// faults.
+//
+// Parameters:
+//   - lines: The rendered lines.
+//
+// Returns:
+//   - []string: The normalized lines.
+func Normalize(lines []string) []string {
+	re := timestampRe()
+
+	normalized := make([]string, 0, len(lines))
+
+	for _, line := range lines {
+		normalized = append(normalized, re.ReplaceAllString(line, "$1 <timestamp>"))
+	}
+
+	return normalized
+}
+
+// AssertGolden asserts that the fault, rendered with faults.LinesOf and normalized with
+// Normalize, is identical to the content of the golden file at path.
+//
+// When the UpdateEnv environment variable is set, the golden file is written instead;
+// creating its directory if needed.
+//
+// Parameters:
+//   - tb: The test.
+//   - fault: The fault to render.
+//   - path: The path of the golden file. (e.g., "testdata/no_such_key.golden")
+//
+// Returns:
+//   - bool: True if the assertion holds, false otherwise.
+func AssertGolden(tb testing.TB, fault flt.Fault, path string) bool {
+	tb.Helper()
+
+	return AssertGoldenLines(tb, faults.LinesOf(fault), path)
+}
+
+// AssertGoldenLines is like AssertGolden but for already rendered lines. (e.g., the lines
+// of a faults.Localizer)
+//
+// Parameters:
+//   - tb: The test.
+//   - lines: The rendered lines.
+//   - path: The path of the golden file.
+//
+// Returns:
+//   - bool: True if the assertion holds, false otherwise.
+func AssertGoldenLines(tb testing.TB, lines []string, path string) bool {
+	tb.Helper()
+
+	got := strings.Join(Normalize(lines), "\n") + "\n"
+
+	if os.Getenv(UpdateEnv) != "" {
+		err := os.MkdirAll(filepath.Dir(path), 0o755)
+		if err == nil {
+			err = os.WriteFile(path, []byte(got), 0o644)
+		}
+
+		if err != nil {
+			tb.Errorf("could not update the golden file %q: %v", path, err)
+
+			return false
+		}
+
+		return true
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		tb.Errorf("could not read the golden file %q: %v (set %s=1 to create it)", path, err, UpdateEnv)
+
+		return false
+	}
+
+	want := string(data)
+	if got == want {
+		return true
+	}
+
+	tb.Errorf("rendered output does not match the golden file %q:\n--- got\n%s--- want\n%s", path, got, want)
+
+	return false
+}
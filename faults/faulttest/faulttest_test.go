@@ -0,0 +1,244 @@
+package faulttest_test
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	flt "github.com/PlayerR9/go-fault"
+	"github.com/PlayerR9/go-fault/faults"
+	"github.com/PlayerR9/go-fault/faults/faulttest"
+)
+
+// spy is a testing.TB that records the failures instead of reporting them. Only the
+// methods used by the assertions are implemented.
+type spy struct {
+	testing.TB
+
+	// failures are the recorded failure messages.
+	failures []string
+}
+
+func (s *spy) Helper() {}
+
+func (s *spy) Errorf(format string, args ...any) {
+	s.failures = append(s.failures, format)
+}
+
+// bare is a fault without a base.
+type bare struct{}
+
+func (bare) Error() string       { return "bare" }
+func (bare) Embeds() flt.Fault   { return nil }
+func (bare) InfoLines() []string { return nil }
+
+func TestAssertions(t *testing.T) {
+	bad := faults.NewBadParameter("x must be positive", faults.WithAt("main.go:12"))
+	usage := faults.NewInvalidUsage("the journal is closed", "Open a new journal")
+	joined := faults.Join(bad, usage)
+
+	tests := []struct {
+		name   string
+		assert func(tb testing.TB) bool
+		want   bool
+	}{
+		{"nil holds", func(tb testing.TB) bool { return faulttest.AssertNil(tb, nil) }, true},
+		{"nil fails", func(tb testing.TB) bool { return faulttest.AssertNil(tb, bad) }, false},
+		{"not nil holds", func(tb testing.TB) bool { return faulttest.AssertNotNil(tb, bad) }, true},
+		{"not nil fails", func(tb testing.TB) bool { return faulttest.AssertNotNil(tb, nil) }, false},
+		{"code holds", func(tb testing.TB) bool { return faulttest.AssertCode(tb, bad, flt.BadParameter) }, true},
+		{"code fails", func(tb testing.TB) bool { return faulttest.AssertCode(tb, bad, flt.OperationFailed) }, false},
+		{"code of nil fails", func(tb testing.TB) bool { return faulttest.AssertCode(tb, nil, flt.BadParameter) }, false},
+		{
+			name: "code of a restored fault holds",
+			assert: func(tb testing.TB) bool {
+				return faulttest.AssertCode(tb, faults.RecordOf(bad).Fault(), flt.BadParameter)
+			},
+			want: true,
+		},
+		{"level holds", func(tb testing.TB) bool { return faulttest.AssertLevel(tb, bad, flt.ERROR) }, true},
+		{"level fails", func(tb testing.TB) bool { return faulttest.AssertLevel(tb, bad, flt.WARNING) }, false},
+		{"key holds", func(tb testing.TB) bool { return faulttest.AssertHasKey(tb, bad, "at") }, true},
+		{"key fails", func(tb testing.TB) bool { return faulttest.AssertHasKey(tb, bad, "before") }, false},
+		{"key of a fault without a base fails", func(tb testing.TB) bool { return faulttest.AssertHasKey(tb, bare{}, "at") }, false},
+		{"suggestion holds", func(tb testing.TB) bool { return faulttest.AssertSuggestion(tb, usage, "journal") }, true},
+		{"suggestion fails", func(tb testing.TB) bool { return faulttest.AssertSuggestion(tb, bad, "journal") }, false},
+		{"suggestion of a fault without a base fails", func(tb testing.TB) bool { return faulttest.AssertSuggestion(tb, bare{}, "journal") }, false},
+		{"join length holds", func(tb testing.TB) bool { return faulttest.AssertJoinLen(tb, joined, 2) }, true},
+		{"join length fails", func(tb testing.TB) bool { return faulttest.AssertJoinLen(tb, joined, 3) }, false},
+		{"join length of a non-join fails", func(tb testing.TB) bool { return faulttest.AssertJoinLen(tb, bad, 1) }, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &spy{}
+
+			got := tt.assert(s)
+			if got != tt.want {
+				t.Errorf("got %t, want %t", got, tt.want)
+			}
+
+			if failed := len(s.failures) > 0; failed == tt.want {
+				t.Errorf("reported %d failures, want the assertion to report %t", len(s.failures), !tt.want)
+			}
+		})
+	}
+}
+
+func TestAssertHasKeyIsSilent(t *testing.T) {
+	bad := faults.NewBadParameter("x must be positive")
+
+	var events int
+
+	defer flt.GlobalHooks.OnCreate(func(flt.Fault) { events++ })()
+
+	_ = faulttest.AssertHasKey(&spy{}, bad, "before")
+
+	if events != 0 {
+		t.Errorf("got %d faults created, want none", events)
+	}
+}
+
+func TestNormalize(t *testing.T) {
+	tests := []struct {
+		name  string
+		lines []string
+		want  []string
+	}{
+		{
+			name:  "english",
+			lines: []string{"[ERROR] (BadParameter) x", "Occurred at: 2024-01-01 00:00:00 +0000 UTC"},
+			want:  []string{"[ERROR] (BadParameter) x", "Occurred at: <timestamp>"},
+		},
+		{
+			name:  "french",
+			lines: []string{"Survenu le : 2024-01-01 00:00:00 +0000 UTC"},
+			want:  []string{"Survenu le : <timestamp>"},
+		},
+		{
+			name:  "spanish",
+			lines: []string{"Ocurrido el: 2024-01-01 00:00:00 +0000 UTC"},
+			want:  []string{"Ocurrido el: <timestamp>"},
+		},
+		{
+			name:  "indented",
+			lines: []string{"- [ERROR] (BadParameter) x", "  Occurred at: 2024-01-01 00:00:00 +0000 UTC"},
+			want:  []string{"- [ERROR] (BadParameter) x", "  Occurred at: <timestamp>"},
+		},
+		{
+			name:  "other lines",
+			lines: []string{"Context:", "- at: Occurred at: noon"},
+			want:  []string{"Context:", "- at: Occurred at: noon"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := faulttest.Normalize(tt.lines)
+
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNormalizeLocalized(t *testing.T) {
+	fault := faults.Join(faults.NewBadParameter("x"), faults.NewBadParameter("y"))
+
+	for _, locale := range []string{"en", "fr", "es"} {
+		t.Run(locale, func(t *testing.T) {
+			lines := faulttest.Normalize(faults.NewLocalizer(nil, locale).LinesOf(fault))
+
+			// The join and each of the joined faults have a timestamp.
+			var count int
+
+			for _, line := range lines {
+				if strings.HasSuffix(line, " <timestamp>") {
+					count++
+				}
+			}
+
+			if count != 3 {
+				t.Errorf("normalized %d timestamps, want 3:\n%s", count, strings.Join(lines, "\n"))
+			}
+		})
+	}
+}
+
+func TestAssertGolden(t *testing.T) {
+	clock := faulttest.NewFakeClock(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+	faulttest.UseClock(t, clock)
+
+	path := filepath.Join(t.TempDir(), "testdata", "bad_parameter.golden")
+	fault := faults.NewBadParameter("x must be positive")
+
+	s := &spy{}
+
+	if faulttest.AssertGolden(s, fault, path) {
+		t.Error("AssertGolden: holds without a golden file")
+	}
+
+	t.Setenv(faulttest.UpdateEnv, "1")
+
+	if !faulttest.AssertGolden(s, fault, path) {
+		t.Fatalf("AssertGolden: could not write the golden file: %q", s.failures)
+	}
+
+	t.Setenv(faulttest.UpdateEnv, "")
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !strings.Contains(string(data), "Occurred at: <timestamp>") {
+		t.Errorf("the golden file is not normalized:\n%s", data)
+	}
+
+	// The timestamp changes but is normalized.
+	clock.Advance(time.Hour)
+
+	if !faulttest.AssertGolden(s, faults.NewBadParameter("x must be positive"), path) {
+		t.Errorf("AssertGolden: does not hold for an identical fault: %q", s.failures)
+	}
+
+	if faulttest.AssertGolden(s, faults.NewBadParameter("y must be positive"), path) {
+		t.Error("AssertGolden: holds for a different fault")
+	}
+}
+
+func TestFakeClock(t *testing.T) {
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	clock := faulttest.NewFakeClock(start)
+
+	if got := clock.Now(); !got.Equal(start) {
+		t.Errorf("Now: got %s, want %s", got, start)
+	}
+
+	if got := clock.Advance(time.Minute); !got.Equal(start.Add(time.Minute)) {
+		t.Errorf("Advance: got %s, want %s", got, start.Add(time.Minute))
+	}
+
+	clock.Set(start)
+
+	if got := clock.Now(); !got.Equal(start) {
+		t.Errorf("Set: got %s, want %s", got, start)
+	}
+
+	t.Run("UseClock", func(t *testing.T) {
+		faulttest.UseClock(t, clock)
+
+		if got := faults.TimestampOf(faults.NewBadParameter("x")); !got.Equal(start) {
+			t.Errorf("got a timestamp of %s, want %s", got, start)
+		}
+	})
+
+	if got := faults.TimestampOf(faults.NewBadParameter("x")); got.Equal(start) {
+		t.Error("the global clock was not restored")
+	}
+}
@@ -0,0 +1,246 @@
+package faults
+
+import (
+	"strconv"
+	"strings"
+
+	flt "github.com/PlayerR9/go-fault"
+)
+
+// PathSegment is a segment of a FieldPath; either a field or an index.
+type PathSegment struct {
+	// Name is the name of the field. Empty if the segment is an index.
+	Name string
+
+	// Index is the index. Only meaningful if IsIndex is true.
+	Index int
+
+	// IsIndex tells whether the segment is an index.
+	IsIndex bool
+}
+
+// String implements the fmt.Stringer interface.
+//
+// Format:
+//
+//	"<name>" or "[<index>]"
+func (s PathSegment) String() string {
+	if s.IsIndex {
+		return "[" + strconv.Itoa(s.Index) + "]"
+	}
+
+	return s.Name
+}
+
+// FieldPath is the path to a field of a structured value, such as a request or a
+// configuration. (e.g., user.addresses[2].zip) It is immutable: its methods return new
+// paths.
+type FieldPath struct {
+	// segments are the segments of the path, from the outermost to the innermost.
+	segments []PathSegment
+}
+
+// Field returns the path to a field of the value at the path.
+//
+// Parameters:
+//   - name: The name of the field.
+//
+// Returns:
+//   - FieldPath: The new path.
+func (p FieldPath) Field(name string) FieldPath {
+	return p.with(PathSegment{Name: name})
+}
+
+// Index returns the path to an element of the value at the path.
+//
+// Parameters:
+//   - index: The index of the element.
+//
+// Returns:
+//   - FieldPath: The new path.
+func (p FieldPath) Index(index int) FieldPath {
+	return p.with(PathSegment{Index: index, IsIndex: true})
+}
+
+// Join returns the path to the value at other; other being relative to the value at p.
+//
+// Parameters:
+//   - other: The relative path.
+//
+// Returns:
+//   - FieldPath: The new path.
+func (p FieldPath) Join(other FieldPath) FieldPath {
+	segments := make([]PathSegment, 0, len(p.segments)+len(other.segments))
+	segments = append(segments, p.segments...)
+	segments = append(segments, other.segments...)
+
+	return FieldPath{
+		segments: segments,
+	}
+}
+
+// with returns the path with the segment appended. The segments of p are never shared
+// with the new path; so that sibling paths do not overwrite each other.
+//
+// Parameters:
+//   - segment: The segment to append.
+//
+// Returns:
+//   - FieldPath: The new path.
+func (p FieldPath) with(segment PathSegment) FieldPath {
+	segments := make([]PathSegment, 0, len(p.segments)+1)
+	segments = append(segments, p.segments...)
+	segments = append(segments, segment)
+
+	return FieldPath{
+		segments: segments,
+	}
+}
+
+// Segments returns the segments of the path.
+//
+// Returns:
+//   - []PathSegment: A copy of the segments, from the outermost to the innermost.
+func (p FieldPath) Segments() []PathSegment {
+	return append([]PathSegment(nil), p.segments...)
+}
+
+// IsRoot checks whether the path has no segment; meaning it refers to the value itself.
+//
+// Returns:
+//   - bool: True if the path is the root, false otherwise.
+func (p FieldPath) IsRoot() bool {
+	return len(p.segments) == 0
+}
+
+// String implements the fmt.Stringer interface.
+//
+// Format:
+//
+//	"<field>.<field>[<index>]..."
+//
+// The root path is rendered as "$".
+func (p FieldPath) String() string {
+	if len(p.segments) == 0 {
+		return "$"
+	}
+
+	var builder strings.Builder
+
+	for i, segment := range p.segments {
+		if i > 0 && !segment.IsIndex {
+			builder.WriteByte('.')
+		}
+
+		builder.WriteString(segment.String())
+	}
+
+	return builder.String()
+}
+
+// Compare compares two paths segment by segment. Fields are compared by name, indices by
+// value (so that [2] comes before [10]) and indices come before fields. A path comes
+// before the paths it is a prefix of.
+//
+// Parameters:
+//   - other: The path to compare with.
+//
+// Returns:
+//   - int: -1 if p comes before other, 1 if it comes after, 0 if they are equal.
+func (p FieldPath) Compare(other FieldPath) int {
+	for i := range min(len(p.segments), len(other.segments)) {
+		a, b := p.segments[i], other.segments[i]
+
+		switch {
+		case a.IsIndex && b.IsIndex:
+			if a.Index != b.Index {
+				if a.Index < b.Index {
+					return -1
+				}
+
+				return 1
+			}
+		case a.IsIndex:
+			return -1
+		case b.IsIndex:
+			return 1
+		default:
+			c := strings.Compare(a.Name, b.Name)
+			if c != 0 {
+				return c
+			}
+		}
+	}
+
+	switch {
+	case len(p.segments) < len(other.segments):
+		return -1
+	case len(p.segments) > len(other.segments):
+		return 1
+	default:
+		return 0
+	}
+}
+
+var (
+	// PathKey is the key of the field path of ErrInvalidField faults.
+	PathKey Key[string] = NewKey[string]("path")
+)
+
+// ErrInvalidField is a BadParameter fault about a field of a structured value. Its
+// message is prefixed with the path of the field.
+type ErrInvalidField struct {
+	flt.Fault
+
+	// Path is the path of the field.
+	Path FieldPath
+}
+
+// Embeds implements the flt.Fault interface.
+func (e ErrInvalidField) Embeds() flt.Fault {
+	return e.Fault
+}
+
+// InfoLines implements the flt.Fault interface.
+//
+// The path is already part of the message; as such, no line is added.
+func (e ErrInvalidField) InfoLines() []string {
+	return nil
+}
+
+// NewInvalidField creates a new ErrInvalidField. It is built on NewBadParameter.
+//
+// Parameters:
+//   - path: The path of the field.
+//   - msg: The message of the fault. (e.g., "must be non-empty")
+//
+// Returns:
+//   - *ErrInvalidField: The new ErrInvalidField. Never returns nil.
+//
+// Example:
+//
+//	path := faults.FieldPath{}.Field("user").Index(2).Field("zip")
+//	err := faults.NewInvalidField(path, "must be 5 digits long")
+//	// [ERROR] (BadParameter) user[2].zip: must be 5 digits long.
+func NewInvalidField(path FieldPath, msg string, opts ...FaultOption) *ErrInvalidField {
+	fault := &ErrInvalidField{
+		Fault: newBadParameterTemplate("{path}: " + escapeTemplate(msg)),
+		Path:  path,
+	}
+
+	_ = Set(fault, PathKey, path.String())
+
+	return apply(fault, opts)
+}
+
+// escapeTemplate escapes the braces of the text so that it is rendered as-is when used
+// in a message template. (See flt.ExpandMessage.)
+//
+// Parameters:
+//   - text: The text to escape.
+//
+// Returns:
+//   - string: The escaped text.
+func escapeTemplate(text string) string {
+	return strings.NewReplacer("{", "{{", "}", "}}").Replace(text)
+}
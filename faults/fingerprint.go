@@ -0,0 +1,130 @@
+package faults
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"hash"
+	"reflect"
+	"strconv"
+	"strings"
+
+	flt "github.com/PlayerR9/go-fault"
+)
+
+const (
+	// FingerprintVersion is the version of the fingerprint algorithm. It is bumped whenever
+	// the algorithm changes in a way that changes the fingerprints; which allows consumers
+	// to know which fingerprints can be compared.
+	FingerprintVersion int = 2
+
+	// DefaultFingerprintFrames is the number of frames used by Fingerprint.
+	DefaultFingerprintFrames int = 5
+)
+
+// FingerprintOptions are the options of FingerprintWith.
+type FingerprintOptions struct {
+	// Frames is the number of frames of the stack trace, from the innermost one, that are
+	// part of the fingerprint. If negative, no frame is used.
+	Frames int
+
+	// Module, if not empty, restricts the frames that are part of the fingerprint to the
+	// ones that start with it. (e.g., "github.com/acme/app") It is only meaningful if the
+	// frames are qualified with the path of their module; which the frames added by Throw
+	// are not, unless the caller qualifies them.
+	Module string
+}
+
+// Fingerprint returns a stable hash that identifies the "kind" of the fault; so that
+// identical faults can be grouped across processes and releases.
+//
+// The fingerprint is computed from the code, level and message, or message template, of
+// the fault's descriptor, the types of its embedding tower and the first
+// DefaultFingerprintFrames frames of its stack trace; so that the same fault thrown from
+// different places is fingerprinted differently. Timestamps and context values are
+// ignored. Faults restored from a Record keep the fingerprint of the original fault.
+//
+// Parameters:
+//   - fault: The fault to fingerprint.
+//
+// Returns:
+//   - string: The fingerprint, of the form "v<version>:<hex>". Empty if the fault is nil.
+func Fingerprint(fault flt.Fault) string {
+	return FingerprintWith(fault, FingerprintOptions{
+		Frames: DefaultFingerprintFrames,
+	})
+}
+
+// FingerprintWith is like Fingerprint but with the given options.
+//
+// Parameters:
+//   - fault: The fault to fingerprint.
+//   - opts: The options of the fingerprint.
+//
+// Returns:
+//   - string: The fingerprint, of the form "v<version>:<hex>". Empty if the fault is nil.
+func FingerprintWith(fault flt.Fault, opts FingerprintOptions) string {
+	if fault == nil {
+		return ""
+	}
+
+	rf, ok := Access[*RecordedFault](fault)
+	if ok && rf.fingerprint != "" {
+		return rf.fingerprint
+	}
+
+	base, ok := Access[*flt.BaseFault](fault)
+	if !ok {
+		panic(flt.BadConstruction.Init())
+	}
+
+	h := sha256.New()
+
+	writeField(h, "v"+strconv.Itoa(FingerprintVersion))
+
+	desc := base.Descriptor()
+
+	// Codes are identified by their type and name rather than by their value; so that
+	// reordering the constants of a code type does not change the fingerprints.
+	code := desc.Code()
+	type_name, _ := codeTypeOf(code)
+	writeField(h, type_name+"."+code.String())
+	writeField(h, desc.Level().String())
+	writeField(h, desc.Message())
+
+	for _, elem := range flt.EmbeddingTower(fault) {
+		writeField(h, reflect.TypeOf(elem).String())
+	}
+
+	if opts.Frames > 0 {
+		var count int
+
+		for _, frame := range base.StackTrace() {
+			if count == opts.Frames {
+				break
+			}
+
+			if opts.Module != "" && !strings.HasPrefix(frame, opts.Module) {
+				continue
+			}
+
+			writeField(h, frame)
+			count++
+		}
+	}
+
+	sum := h.Sum(nil)
+
+	return "v" + strconv.Itoa(FingerprintVersion) + ":" + hex.EncodeToString(sum[:16])
+}
+
+// writeField writes a length-prefixed field to the hash; so that no two different
+// sequences of fields give the same input.
+//
+// Parameters:
+//   - h: The hash to write to.
+//   - field: The field to write.
+func writeField(h hash.Hash, field string) {
+	_, _ = h.Write([]byte(strconv.Itoa(len(field))))
+	_, _ = h.Write([]byte{':'})
+	_, _ = h.Write([]byte(field))
+}
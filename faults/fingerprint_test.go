@@ -0,0 +1,151 @@
+package faults_test
+
+import (
+	"strings"
+	"testing"
+
+	flt "github.com/PlayerR9/go-fault"
+	"github.com/PlayerR9/go-fault/faults"
+)
+
+// withFrames adds the frames to the fault's stack trace.
+func withFrames(fault flt.Fault, frames ...string) flt.Fault {
+	for _, frame := range frames {
+		fault = faults.Throw(fault, frame)
+	}
+
+	return fault
+}
+
+func TestFingerprint(t *testing.T) {
+	desc := flt.NewDescriptor(flt.ERROR, flt.BadParameter, "{name} must be positive")
+
+	withKey := func(name string) flt.Fault {
+		fault := desc.Init()
+		_ = faults.AddKey(fault, "name", name)
+
+		return fault
+	}
+
+	tests := []struct {
+		name string
+		a, b flt.Fault
+		same bool
+	}{
+		{
+			name: "context values are ignored",
+			a:    withKey("x"),
+			b:    withKey("y"),
+			same: true,
+		},
+		{
+			name: "templates differ",
+			a:    faults.NewBadParameter("x must be positive"),
+			b:    faults.NewBadParameter("y must be positive"),
+			same: false,
+		},
+		{
+			name: "levels differ",
+			a:    flt.NewDescriptor(flt.ERROR, flt.BadParameter, "x").Init(),
+			b:    flt.NewDescriptor(flt.WARNING, flt.BadParameter, "x").Init(),
+			same: false,
+		},
+		{
+			name: "codes differ",
+			a:    flt.NewDescriptor(flt.ERROR, flt.BadParameter, "x").Init(),
+			b:    flt.NewDescriptor(flt.ERROR, flt.OperationFailed, "x").Init(),
+			same: false,
+		},
+		{
+			name: "throw sites differ",
+			a:    faults.Throw(faults.NewBadParameter("x"), "Load"),
+			b:    faults.Throw(faults.NewBadParameter("x"), "Save"),
+			same: false,
+		},
+		{
+			name: "throw sites are the same",
+			a:    faults.Throw(faults.NewBadParameter("x"), "(*Set).Get"),
+			b:    faults.Throw(faults.NewBadParameter("x"), "(*Set).Get"),
+			same: true,
+		},
+		{
+			name: "towers differ",
+			a:    faults.Wrap(faults.NewBadParameter("y"), flt.BadParameter, "x"),
+			b:    flt.NewDescriptor(flt.ERROR, flt.BadParameter, "x").Init(),
+			same: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a, b := faults.Fingerprint(tt.a), faults.Fingerprint(tt.b)
+
+			if !strings.HasPrefix(a, "v2:") {
+				t.Errorf("got %q, want the prefix %q", a, "v2:")
+			}
+
+			if (a == b) != tt.same {
+				t.Errorf("got %q and %q; same = %t, want %t", a, b, a == b, tt.same)
+			}
+		})
+	}
+}
+
+func TestFingerprintWithModule(t *testing.T) {
+	opts := faults.FingerprintOptions{
+		Frames: 2,
+		Module: "example.com/app",
+	}
+
+	tests := []struct {
+		name string
+		a, b []string
+		same bool
+	}{
+		{
+			name: "frames of other modules are ignored",
+			a:    []string{"example.com/app.f", "runtime.main"},
+			b:    []string{"example.com/app.f", "testing.tRunner"},
+			same: true,
+		},
+		{
+			name: "in-module frames differ",
+			a:    []string{"example.com/app.f"},
+			b:    []string{"example.com/app.g"},
+			same: false,
+		},
+		{
+			name: "frames past the limit are ignored",
+			a:    []string{"example.com/app.f", "example.com/app.g", "example.com/app.h"},
+			b:    []string{"example.com/app.f", "example.com/app.g", "example.com/app.i"},
+			same: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := faults.FingerprintWith(withFrames(faults.NewBadParameter("x"), tt.a...), opts)
+			b := faults.FingerprintWith(withFrames(faults.NewBadParameter("x"), tt.b...), opts)
+
+			if (a == b) != tt.same {
+				t.Errorf("got %q and %q; same = %t, want %t", a, b, a == b, tt.same)
+			}
+		})
+	}
+}
+
+func TestFingerprintRestoredCode(t *testing.T) {
+	fault := flt.NewDescriptor(flt.ERROR, flt.BadParameter, "x").Init()
+
+	record := faults.RecordOf(fault)
+	record.Fingerprint = ""
+
+	// The restored code is a flt.RecordedCode; it must hash as the original code. The
+	// RecordedFault layer is left out so that the towers are the same.
+	got := faults.Fingerprint(record.Fault().Embeds())
+	want := faults.Fingerprint(fault)
+
+	if got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
@@ -1,8 +1,13 @@
 package faults
 
-import flt "github.com/PlayerR9/go-fault"
+import (
+	"context"
 
-// Throw adds a stack trace's frame to the fault and returns the fault.
+	flt "github.com/PlayerR9/go-fault"
+)
+
+// Throw adds a stack trace's frame to the fault and returns the fault. A flt.ThrowEvent
+// is emitted to flt.GlobalHooks.
 //
 // Parameters:
 //   - frame: The stack trace's frame to add.
@@ -21,6 +26,12 @@ func Throw(fault flt.Fault, frame string) flt.Fault {
 
 	base.AppendFrame(frame)
 
+	flt.Emit(context.Background(), flt.Event{
+		Kind:  flt.ThrowEvent,
+		Fault: fault,
+		Frame: frame,
+	})
+
 	return fault
 }
 
@@ -50,6 +61,12 @@ func try(fault *flt.Fault, fn func()) {
 		default:
 			*fault = NewErrPanic(r)
 		}
+
+		flt.Emit(context.Background(), flt.Event{
+			Kind:  flt.RecoverEvent,
+			Fault: *fault,
+			Value: r,
+		})
 	}()
 
 	fn()
@@ -68,6 +85,8 @@ func try(fault *flt.Fault, fn func()) {
 //   - If the panic value is flt.Fault, it returns it.
 //   - If the panic value is error, it returns a new FaultErr with the error.
 //   - In all other cases, it returns a new ErrPanic with the panic value.
+//
+// When a panic is recovered, a flt.RecoverEvent is emitted to flt.GlobalHooks.
 func Try(fn func()) flt.Fault {
 	if fn == nil {
 		return nil
@@ -0,0 +1,109 @@
+package faults_test
+
+import (
+	"context"
+	"testing"
+
+	flt "github.com/PlayerR9/go-fault"
+	"github.com/PlayerR9/go-fault/faults"
+)
+
+func TestCreateEvent(t *testing.T) {
+	at := faults.WithAt("main.go:12")
+
+	tests := []struct {
+		name string
+		new  func() flt.Fault
+		key  string
+	}{
+		{
+			name: "NewBadParameter",
+			new:  func() flt.Fault { return faults.NewBadParameter("x", at) },
+			key:  "at",
+		},
+		{
+			name: "NewNilParameter",
+			new:  func() flt.Fault { return faults.NewNilParameter("x", at) },
+			key:  "at",
+		},
+		{
+			name: "NewInvalidField",
+			new:  func() flt.Fault { return faults.NewInvalidField(faults.FieldPath{}.Field("zip"), "x", at) },
+			key:  "path",
+		},
+		{
+			name: "NewErrNoSuchKey",
+			new:  func() flt.Fault { return faults.NewErrNoSuchKey("k", nil, at) },
+			key:  "at",
+		},
+		{
+			name: "NewNotFound",
+			new:  func() flt.Fault { return faults.NewNotFound("x", at) },
+			key:  "at",
+		},
+		{
+			name: "Wrap",
+			new: func() flt.Fault {
+				return faults.Wrap(faults.NewBadParameter("y"), flt.OperationFailed, "x", at)
+			},
+			key: "at",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var created []flt.Fault
+			var had []bool
+
+			remove := flt.OnCreate(func(fault flt.Fault) {
+				created = append(created, fault)
+				had = append(had, faults.HasKey(tt.key)(fault))
+			})
+
+			got := tt.new()
+			remove()
+
+			if len(created) == 0 {
+				t.Fatal("no create event was emitted")
+			}
+
+			// The last event is the one of the returned fault; the ones before, if any,
+			// are the ones of its causes.
+			last := len(created) - 1
+
+			if created[last] != got {
+				t.Errorf("the event's fault is %T, want the returned %T", created[last], got)
+			}
+
+			if !had[last] {
+				t.Errorf("the key %q was not set when the event was emitted", tt.key)
+			}
+		})
+	}
+}
+
+func TestEventsReachScopedHooks(t *testing.T) {
+	rec := faults.NewRecorder()
+
+	scoped := flt.NewHooks()
+	defer rec.Attach(scoped)()
+
+	global := faults.NewRecorder()
+	defer global.Attach(nil)()
+
+	ctx := flt.WithHooks(context.Background(), scoped)
+
+	fault := flt.NewCtx(ctx, flt.BadParameter, "x")
+	_ = faults.Throw(fault, "main.go:12")
+	_ = faults.Join(fault, faults.NewBadParameter("y"))
+
+	if got := len(rec.Events(flt.CreateEvent)); got != 1 {
+		t.Errorf("scoped hooks: got %d create events, want 1", got)
+	}
+
+	for _, kind := range []flt.EventKind{flt.CreateEvent, flt.ThrowEvent, flt.JoinEvent} {
+		if len(global.Events(kind)) == 0 {
+			t.Errorf("global hooks: no %s event", kind)
+		}
+	}
+}
@@ -1,7 +1,9 @@
 package faults
 
 import (
+	"context"
 	"fmt"
+	"slices"
 
 	flt "github.com/PlayerR9/go-fault"
 )
@@ -25,13 +27,58 @@ func (jf JoinFault) Embeds() flt.Fault {
 //
 // Format:
 //
-//	"- Faults: <faults>"
+//	"- <fault>"
+//	"  <info>"
+//	"- ..."
+//
+// Where:
+//   - <fault>: The message of a joined fault, as given by ErrorOf.
+//   - <info>: The additional information of the embedding tower of that fault, followed
+//     by the "Caused by:" section of its cause; if any.
 func (jf JoinFault) InfoLines() []string {
+	return jf.InfoLinesIn(nil)
+}
+
+// InfoLinesIn implements the flt.LocalizedInfoer interface.
+//
+// If tr also has ErrorOf and LinesOf methods, such as Localizer, they render the
+// messages and the causes of the joined faults. Otherwise, the causes are rendered with
+// LinesOf, or with UnredactedLinesOf if tr requests an unredacted view. (See
+// flt.ShowsSensitive.)
+func (jf JoinFault) InfoLinesIn(tr flt.Translator) []string {
+	error_of := ErrorOf
+
+	ef, ok := tr.(interface{ ErrorOf(fault flt.Fault) string })
+	if ok {
+		error_of = ef.ErrorOf
+	}
+
+	lines_of := LinesOf
+
+	lo, ok := tr.(interface {
+		LinesOf(fault flt.Fault) []string
+	})
+	if ok {
+		lines_of = lo.LinesOf
+	} else if flt.ShowsSensitive(tr) {
+		lines_of = UnredactedLinesOf
+	}
+
 	var lines []string
 
 	for _, fault := range jf.faults {
-		tmp := fault.InfoLines()
-		lines = append(lines, tmp...)
+		lines = append(lines, "- "+error_of(fault))
+
+		tmp := flt.InfoLinesIn(fault, tr)
+		tmp = appendCause(tmp, fault, translate(tr, "Caused by:"), lines_of)
+
+		for _, line := range tmp {
+			if line == "" {
+				lines = append(lines, "")
+			} else {
+				lines = append(lines, "  "+line)
+			}
+		}
 	}
 
 	return lines
@@ -45,7 +92,8 @@ func (jf JoinFault) InfoLines() []string {
 // Returns:
 //   - flt.Fault: The joined fault.
 //
-// This function returns nil if all the faults are nil.
+// This function returns nil if all the faults are nil. Otherwise, a flt.JoinEvent is
+// emitted to flt.GlobalHooks.
 func Join(faults ...flt.Fault) flt.Fault {
 	// 1. Remove nil faults.
 	var count int
@@ -69,22 +117,44 @@ func Join(faults ...flt.Fault) flt.Fault {
 	}
 
 	// 2. Get the highest level of severity.
-	highest := LevelOf(faults[0])
+	highest := LevelOf(result[0])
 
-	for _, fault := range faults[1:] {
+	for _, fault := range result[1:] {
 		level := LevelOf(fault)
 
-		if level > highest {
+		if level.Compare(highest) > 0 {
 			highest = level
 		}
 	}
 
-	base := flt.WithLevel(highest, flt.FaultJoin, fmt.Sprintf("joined %d faults", len(faults)))
+	base := flt.NewBase(flt.NewDescriptor(highest, flt.FaultJoin, fmt.Sprintf("joined %d faults", len(result))))
 
-	js := &JoinFault{
+	js := apply(&JoinFault{
 		Fault:  base,
-		faults: faults,
-	}
+		faults: result,
+	}, nil)
+
+	flt.Emit(context.Background(), flt.Event{
+		Kind:   flt.JoinEvent,
+		Fault:  js,
+		Faults: result,
+	})
 
 	return js
 }
+
+// Faults returns the faults that have been joined.
+//
+// Returns:
+//   - []flt.Fault: A copy of the joined faults. Never contains nil.
+func (jf JoinFault) Faults() []flt.Fault {
+	return slices.Clone(jf.faults)
+}
+
+// Unwrap returns the faults that have been joined; so that Traverse and Walk reach them.
+//
+// Returns:
+//   - []flt.Fault: A copy of the joined faults. Never contains nil.
+func (jf JoinFault) Unwrap() []flt.Fault {
+	return jf.Faults()
+}
@@ -0,0 +1,597 @@
+// Package journal implements a local, rotating, journal of faults. Each fault is appended
+// to a file as a single line of JSON (see faults.Record) and the file is rotated according
+// to its size and age; which gives post-mortem history on machines without log shipping.
+//
+// A journal is a faults.Sink, so it is usually placed behind a faults.Reporter:
+//
+//	j, err := journal.Open("/var/log/app/faults.jsonl", journal.Options{
+//		MaxSize:     10 << 20,
+//		MaxAge:      24 * time.Hour,
+//		MaxSegments: 7,
+//		Compress:    true,
+//	})
+//	if err != nil {
+//		// ...
+//	}
+//	defer j.Close()
+//
+//	reporter := faults.NewReporter(j)
+package journal
+
+import (
+	"compress/gzip"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	flt "github.com/PlayerR9/go-fault"
+	"github.com/PlayerR9/go-fault/faults"
+)
+
+const (
+	// segmentLayout is the layout of the timestamp appended to the name of rotated
+	// segments. It sorts lexicographically in chronological order.
+	segmentLayout string = "20060102T150405.000000000Z"
+
+	// gzipExt is the extension of the compressed segments.
+	gzipExt string = ".gz"
+)
+
+// Options are the options of a Journal.
+type Options struct {
+	// MaxSize is the size, in bytes, above which the current segment is rotated. If not
+	// positive, the journal is not rotated by size.
+	MaxSize int64
+
+	// MaxAge is the age above which the current segment is rotated. The age of a segment
+	// is measured from when it was created by the journal or, for a segment that existed
+	// before the journal was opened, from its last modification. If not positive, the
+	// journal is not rotated by age.
+	MaxAge time.Duration
+
+	// MaxSegments is the number of rotated segments that are kept; the oldest ones being
+	// removed first. If not positive, all the rotated segments are kept.
+	MaxSegments int
+
+	// Compress tells whether the rotated segments are compressed with gzip.
+	Compress bool
+
+	// Now gives the current time. If nil, flt.Now is used. It is mostly meant for tests.
+	Now func() time.Time
+}
+
+// Journal appends faults to a local file, as lines of JSON, and rotates it. It is safe
+// for concurrent use.
+type Journal struct {
+	// mu guards the fields below.
+	mu sync.Mutex
+
+	// path is the path of the current segment.
+	path string
+
+	// opts are the options of the journal.
+	opts Options
+
+	// file is the current segment. Nil once the journal is closed or if it could not be
+	// reopened after a rotation; in which case the next write reopens it.
+	file *os.File
+
+	// closed tells whether the journal is closed.
+	closed bool
+
+	// size is the size of the current segment.
+	size int64
+
+	// created is the time the current segment was created.
+	created time.Time
+}
+
+// Open opens the journal whose current segment is at path; creating it if needed.
+//
+// Parameters:
+//   - path: The path of the current segment. Rotated segments are created next to it,
+//     with a timestamp, and a sequence number if needed, appended to their name.
+//   - opts: The options of the journal.
+//
+// Returns:
+//   - *Journal: The opened journal. Nil if an error occurred.
+//   - flt.Fault: The fault that occurred while opening the journal, if any.
+func Open(path string, opts Options) (*Journal, flt.Fault) {
+	if path == "" {
+		return nil, faults.NewBadParameter("path must be non-empty")
+	}
+
+	if opts.Now == nil {
+		opts.Now = flt.Now
+	}
+
+	j := &Journal{
+		path: path,
+		opts: opts,
+	}
+
+	err := j.open()
+	if err != nil {
+		return nil, err
+	}
+
+	return j, nil
+}
+
+// open opens the current segment.
+//
+// Returns:
+//   - flt.Fault: The fault that occurred while opening the segment, if any.
+func (j *Journal) open() flt.Fault {
+	file, err := os.OpenFile(j.path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+	if err != nil {
+		return faults.FromErrWithMsg(err, "could not open the journal")
+	}
+
+	info, err := file.Stat()
+	if err != nil {
+		_ = file.Close()
+
+		return faults.FromErrWithMsg(err, "could not open the journal")
+	}
+
+	j.file = file
+	j.size = info.Size()
+
+	if j.size > 0 {
+		j.created = info.ModTime()
+	} else {
+		j.created = j.opts.Now()
+	}
+
+	return nil
+}
+
+// Send implements the faults.Sink interface. The report is appended as a line of JSON.
+// (See faults.Report.MarshalJSON.)
+func (j *Journal) Send(report faults.Report) flt.Fault {
+	if report.Fault == nil {
+		return nil
+	}
+
+	data, err := json.Marshal(report)
+	if err != nil {
+		return faults.FromErrWithMsg(err, "could not encode the report in JSON")
+	}
+
+	return j.write(data)
+}
+
+// Append appends the fault as a line of JSON. (See faults.EncodeJSON.)
+//
+// Parameters:
+//   - fault: The fault to append. Does nothing if nil.
+//
+// Returns:
+//   - flt.Fault: The fault that occurred while appending, if any.
+func (j *Journal) Append(fault flt.Fault) flt.Fault {
+	if fault == nil {
+		return nil
+	}
+
+	data, err := faults.EncodeJSON(fault)
+	if err != nil {
+		return err
+	}
+
+	return j.write(data)
+}
+
+// write appends a line to the current segment; rotating it beforehand if needed.
+//
+// Parameters:
+//   - data: The line, without its trailing newline.
+//
+// Returns:
+//   - flt.Fault: The fault that occurred while writing, if any.
+func (j *Journal) write(data []byte) flt.Fault {
+	if j == nil {
+		return faults.NewNilReceiver()
+	}
+
+	data = append(data, '\n')
+
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	e := j.ensureOpen()
+	if e != nil {
+		return e
+	}
+
+	var rotate_err flt.Fault
+
+	if j.shouldRotate(int64(len(data))) {
+		rotate_err = j.rotate()
+
+		// The journal stays usable whenever a segment could be (re)opened; as such, the
+		// line is written even if the rotation partly failed.
+		if j.file == nil {
+			return rotate_err
+		}
+	}
+
+	n, err := j.file.Write(data)
+	j.size += int64(n)
+
+	if err != nil {
+		return combine(rotate_err, faults.FromErrWithMsg(err, "could not write to the journal"))
+	}
+
+	return rotate_err
+}
+
+// ensureOpen checks that the journal is not closed and reopens the current segment if a
+// previous rotation could not.
+//
+// Returns:
+//   - flt.Fault: The fault that occurred if the journal is closed or cannot be reopened.
+func (j *Journal) ensureOpen() flt.Fault {
+	if j.closed {
+		return faults.NewInvalidUsage("the journal is closed", "Open a new journal with journal.Open")
+	}
+
+	if j.file != nil {
+		return nil
+	}
+
+	return j.open()
+}
+
+// combine combines the faults into a single one.
+//
+// Parameters:
+//   - elems: The faults to combine. May contain nil.
+//
+// Returns:
+//   - flt.Fault: Nil if all the faults are nil, the only non-nil fault if there is one and
+//     their faults.Join otherwise.
+func combine(elems ...flt.Fault) flt.Fault {
+	var fault flt.Fault
+
+	var count int
+
+	for _, elem := range elems {
+		if elem != nil {
+			fault = elem
+			count++
+		}
+	}
+
+	if count > 1 {
+		return faults.Join(elems...)
+	}
+
+	return fault
+}
+
+// shouldRotate checks whether the current segment must be rotated before writing the
+// given number of bytes.
+//
+// Parameters:
+//   - n: The number of bytes about to be written.
+//
+// Returns:
+//   - bool: True if the current segment must be rotated, false otherwise.
+func (j *Journal) shouldRotate(n int64) bool {
+	if j.size == 0 {
+		return false
+	}
+
+	if j.opts.MaxSize > 0 && j.size+n > j.opts.MaxSize {
+		return true
+	}
+
+	return j.opts.MaxAge > 0 && j.opts.Now().Sub(j.created) >= j.opts.MaxAge
+}
+
+// Rotate rotates the current segment; regardless of its size and age. Does nothing if
+// the current segment is empty.
+//
+// Returns:
+//   - flt.Fault: The fault that occurred while rotating, if any.
+func (j *Journal) Rotate() flt.Fault {
+	if j == nil {
+		return faults.NewNilReceiver()
+	}
+
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	e := j.ensureOpen()
+	if e != nil {
+		return e
+	}
+
+	if j.size == 0 {
+		return nil
+	}
+
+	return j.rotate()
+}
+
+// rotate closes the current segment, renames it, opens a new current segment, compresses
+// the rotated segment if needed and removes the segments that exceed the retention.
+//
+// A segment is reopened on every path: the current one if it could not be renamed, the
+// new one otherwise. As such, the failures to compress and to prune are reported but
+// leave the journal usable. Only if no segment can be opened is j.file left nil; in which
+// case the next write tries again.
+//
+// Returns:
+//   - flt.Fault: The fault that occurred while rotating, if any.
+func (j *Journal) rotate() flt.Fault {
+	var close_err flt.Fault
+
+	err := j.file.Close()
+	j.file = nil
+
+	if err != nil {
+		close_err = faults.FromErrWithMsg(err, "could not close the journal's segment")
+	}
+
+	rotated := j.rotatedPath()
+
+	err = os.Rename(j.path, rotated)
+	if err != nil {
+		return combine(close_err, faults.FromErrWithMsg(err, "could not rotate the journal's segment"), j.open())
+	}
+
+	e := j.open()
+	if e != nil {
+		return combine(close_err, e)
+	}
+
+	var compress_err flt.Fault
+
+	if j.opts.Compress {
+		compress_err = compress(rotated)
+	}
+
+	return combine(close_err, compress_err, j.prune())
+}
+
+// rotatedPath returns the path to rename the current segment to: its path followed by the
+// current time and, if a segment was already rotated at that time, by a sequence number;
+// so that no rotated segment is overwritten.
+//
+// Format:
+//
+//	"<path>.<timestamp>[-<sequence>]"
+//
+// Returns:
+//   - string: The path of the rotated segment.
+func (j *Journal) rotatedPath() string {
+	stamped := j.path + "." + j.opts.Now().UTC().Format(segmentLayout)
+
+	rotated := stamped
+
+	for seq := 1; exists(rotated) || exists(rotated+gzipExt); seq++ {
+		rotated = stamped + "-" + strconv.Itoa(seq)
+	}
+
+	return rotated
+}
+
+// exists checks whether a file exists at the path.
+//
+// Parameters:
+//   - path: The path of the file.
+//
+// Returns:
+//   - bool: True if the file exists, false otherwise.
+func exists(path string) bool {
+	_, err := os.Lstat(path)
+	return err == nil
+}
+
+// compress compresses the file with gzip and removes the original.
+//
+// Parameters:
+//   - path: The path of the file to compress.
+//
+// Returns:
+//   - flt.Fault: The fault that occurred while compressing, if any.
+func compress(path string) flt.Fault {
+	src, err := os.Open(path)
+	if err != nil {
+		return faults.FromErrWithMsg(err, "could not compress the journal's segment")
+	}
+
+	defer src.Close()
+
+	dst, err := os.OpenFile(path+gzipExt, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0o644)
+	if err != nil {
+		return faults.FromErrWithMsg(err, "could not compress the journal's segment")
+	}
+
+	zw := gzip.NewWriter(dst)
+
+	_, err = io.Copy(zw, src)
+	if err == nil {
+		err = zw.Close()
+	}
+
+	if err == nil {
+		err = dst.Close()
+	} else {
+		_ = dst.Close()
+	}
+
+	if err != nil {
+		_ = os.Remove(path + gzipExt)
+
+		return faults.FromErrWithMsg(err, "could not compress the journal's segment")
+	}
+
+	_ = src.Close()
+
+	err = os.Remove(path)
+	if err != nil {
+		return faults.FromErrWithMsg(err, "could not remove the uncompressed journal's segment")
+	}
+
+	return nil
+}
+
+// prune removes the oldest rotated segments that exceed the retention.
+//
+// Returns:
+//   - flt.Fault: The fault that occurred while removing the segments, if any.
+func (j *Journal) prune() flt.Fault {
+	if j.opts.MaxSegments <= 0 {
+		return nil
+	}
+
+	segments, err := Segments(j.path)
+	if err != nil {
+		return err
+	}
+
+	rotated := segments[:len(segments)-1]
+
+	for len(rotated) > j.opts.MaxSegments {
+		e := os.Remove(rotated[0])
+		if e != nil && !os.IsNotExist(e) {
+			return faults.FromErrWithMsg(e, "could not remove an old journal's segment")
+		}
+
+		rotated = rotated[1:]
+	}
+
+	return nil
+}
+
+// Close closes the journal.
+//
+// Returns:
+//   - flt.Fault: The fault that occurred while closing, if any.
+func (j *Journal) Close() flt.Fault {
+	if j == nil {
+		return faults.NewNilReceiver()
+	}
+
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	j.closed = true
+
+	if j.file == nil {
+		return nil
+	}
+
+	err := j.file.Close()
+	j.file = nil
+
+	if err != nil {
+		return faults.FromErrWithMsg(err, "could not close the journal")
+	}
+
+	return nil
+}
+
+// Segments returns the segments of the journal whose current segment is at path; from
+// the oldest rotated segment to the current one.
+//
+// Parameters:
+//   - path: The path of the current segment.
+//
+// Returns:
+//   - []string: The paths of the segments. The last one is always path, even if it does
+//     not exist.
+//   - flt.Fault: The fault that occurred while listing the segments, if any.
+func Segments(path string) ([]string, flt.Fault) {
+	dir, name := filepath.Split(path)
+
+	entries, err := os.ReadDir(filepath.Clean(dir + "."))
+	if err != nil && !os.IsNotExist(err) {
+		return nil, faults.FromErrWithMsg(err, "could not list the journal's segments")
+	}
+
+	prefix := name + "."
+
+	type segment struct {
+		path  string
+		stamp string
+		seq   int
+	}
+
+	var rotated []segment
+
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasPrefix(entry.Name(), prefix) {
+			continue
+		}
+
+		stamp, seq, ok := parseSuffix(strings.TrimPrefix(entry.Name(), prefix))
+		if ok {
+			rotated = append(rotated, segment{path: dir + entry.Name(), stamp: stamp, seq: seq})
+		}
+	}
+
+	slices.SortFunc(rotated, func(a, b segment) int {
+		c := strings.Compare(a.stamp, b.stamp)
+		if c != 0 {
+			return c
+		}
+
+		return a.seq - b.seq
+	})
+
+	segments := make([]string, 0, len(rotated)+1)
+
+	for _, seg := range rotated {
+		segments = append(segments, seg.path)
+	}
+
+	segments = append(segments, path)
+
+	return segments, nil
+}
+
+// parseSuffix parses the suffix that a rotation appends to the name of the current
+// segment. (See Journal.rotatedPath.)
+//
+// Format:
+//
+//	"<timestamp>[-<sequence>][.gz]"
+//
+// Parameters:
+//   - suffix: The suffix.
+//
+// Returns:
+//   - string: The timestamp.
+//   - int: The sequence number. 0 if the suffix has none.
+//   - bool: True if the suffix is that of a rotated segment, false otherwise.
+func parseSuffix(suffix string) (string, int, bool) {
+	suffix = strings.TrimSuffix(suffix, gzipExt)
+
+	stamp, seq_str, has_seq := strings.Cut(suffix, "-")
+
+	_, err := time.Parse(segmentLayout, stamp)
+	if err != nil {
+		return "", 0, false
+	}
+
+	if !has_seq {
+		return stamp, 0, true
+	}
+
+	seq, err := strconv.Atoi(seq_str)
+	if err != nil || seq < 1 || seq_str != strconv.Itoa(seq) {
+		return "", 0, false
+	}
+
+	return stamp, seq, true
+}
@@ -0,0 +1,275 @@
+package journal_test
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	flt "github.com/PlayerR9/go-fault"
+	"github.com/PlayerR9/go-fault/faults"
+	"github.com/PlayerR9/go-fault/faults/journal"
+)
+
+// clock is a manual clock for the journal's options.
+type clock struct {
+	now time.Time
+}
+
+func (c *clock) Now() time.Time {
+	return c.now
+}
+
+// countEntries returns the number of entries of the journal at path.
+func countEntries(tb testing.TB, path string) int {
+	tb.Helper()
+
+	r, err := journal.NewReader(path, journal.Filter{})
+	if err != nil {
+		tb.Fatalf("NewReader: %s", faults.ErrorOf(err))
+	}
+
+	var count int
+
+	for range r.Entries() {
+		count++
+	}
+
+	if err := r.Err(); err != nil {
+		tb.Fatalf("Reader.Err: %s", faults.ErrorOf(err))
+	}
+
+	return count
+}
+
+func TestJournalRotation(t *testing.T) {
+	tests := []struct {
+		name    string
+		opts    journal.Options
+		appends int
+		step    time.Duration
+
+		// segments is the number of segments left, including the current one.
+		segments int
+
+		// entries is the number of entries left over all the segments.
+		entries int
+		gzipped bool
+	}{
+		{
+			name:     "no rotation",
+			appends:  3,
+			segments: 1,
+			entries:  3,
+		},
+		{
+			name:     "by size",
+			opts:     journal.Options{MaxSize: 1},
+			appends:  3,
+			step:     time.Second,
+			segments: 3,
+			entries:  3,
+		},
+		{
+			name:     "by age",
+			opts:     journal.Options{MaxAge: time.Minute},
+			appends:  3,
+			step:     time.Hour,
+			segments: 3,
+			entries:  3,
+		},
+		{
+			name:     "compressed",
+			opts:     journal.Options{MaxSize: 1, Compress: true},
+			appends:  2,
+			step:     time.Second,
+			segments: 2,
+			entries:  2,
+			gzipped:  true,
+		},
+		{
+			name:     "at the same time",
+			opts:     journal.Options{MaxSize: 1},
+			appends:  3,
+			segments: 3,
+			entries:  3,
+		},
+		{
+			name:     "compressed at the same time",
+			opts:     journal.Options{MaxSize: 1, Compress: true},
+			appends:  3,
+			segments: 3,
+			entries:  3,
+			gzipped:  true,
+		},
+		{
+			name:     "pruned",
+			opts:     journal.Options{MaxSize: 1, MaxSegments: 1},
+			appends:  4,
+			step:     time.Second,
+			segments: 2,
+			entries:  2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "faults.jsonl")
+
+			c := &clock{now: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)}
+			tt.opts.Now = c.Now
+
+			j, err := journal.Open(path, tt.opts)
+			if err != nil {
+				t.Fatalf("Open: %s", faults.ErrorOf(err))
+			}
+
+			for range tt.appends {
+				err := j.Append(faults.NewBadParameter("x must be positive"))
+				if err != nil {
+					t.Fatalf("Append: %s", faults.ErrorOf(err))
+				}
+
+				c.now = c.now.Add(tt.step)
+			}
+
+			err = j.Close()
+			if err != nil {
+				t.Fatalf("Close: %s", faults.ErrorOf(err))
+			}
+
+			segments, err := journal.Segments(path)
+			if err != nil {
+				t.Fatalf("Segments: %s", faults.ErrorOf(err))
+			}
+
+			if len(segments) != tt.segments {
+				t.Fatalf("got %d segments, want %d: %v", len(segments), tt.segments, segments)
+			}
+
+			for _, segment := range segments[:len(segments)-1] {
+				if strings.HasSuffix(segment, ".gz") != tt.gzipped {
+					t.Errorf("segment %s: gzipped = %t, want %t", segment, !tt.gzipped, tt.gzipped)
+				}
+			}
+
+			got := countEntries(t, path)
+			if got != tt.entries {
+				t.Errorf("got %d entries, want %d", got, tt.entries)
+			}
+		})
+	}
+}
+
+func TestJournalRotationFailure(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "faults.jsonl")
+
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	j, err := journal.Open(path, journal.Options{
+		Compress: true,
+		Now:      func() time.Time { return now },
+	})
+	if err != nil {
+		t.Fatalf("Open: %s", faults.ErrorOf(err))
+	}
+
+	defer j.Close()
+
+	err = j.Append(faults.NewBadParameter("first"))
+	if err != nil {
+		t.Fatalf("Append: %s", faults.ErrorOf(err))
+	}
+
+	// The current segment removed behind the journal's back makes the renaming fail.
+	e := os.Remove(path)
+	if e != nil {
+		t.Fatal(e)
+	}
+
+	err = j.Rotate()
+	if err == nil {
+		t.Fatal("Rotate: want a fault, got nil")
+	}
+
+	err = j.Append(faults.NewBadParameter("second"))
+	if err != nil {
+		t.Fatalf("Append after a failed rotation: %s", faults.ErrorOf(err))
+	}
+
+	got := countEntries(t, path)
+	if got != 1 {
+		t.Errorf("got %d entries, want 1", got)
+	}
+}
+
+func TestSegments(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "faults.jsonl")
+
+	stamp := path + ".20240101T000000.000000000Z"
+
+	names := []string{
+		stamp + "-10",
+		stamp + "-2.gz",
+		stamp,
+		stamp + "-1",
+		path + ".20231231T000000.000000000Z.gz",
+
+		// Not segments.
+		stamp + "-0",
+		stamp + "-01",
+		stamp + "-x",
+		path + ".bak",
+	}
+
+	for _, name := range names {
+		e := os.WriteFile(name, nil, 0o644)
+		if e != nil {
+			t.Fatal(e)
+		}
+	}
+
+	got, err := journal.Segments(path)
+	if err != nil {
+		t.Fatalf("Segments: %s", faults.ErrorOf(err))
+	}
+
+	want := []string{
+		path + ".20231231T000000.000000000Z.gz",
+		stamp,
+		stamp + "-1",
+		stamp + "-2.gz",
+		stamp + "-10",
+		path,
+	}
+
+	if !slices.Equal(got, want) {
+		t.Errorf("got:\n%s\nwant:\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+}
+
+func TestJournalClosed(t *testing.T) {
+	j, err := journal.Open(filepath.Join(t.TempDir(), "faults.jsonl"), journal.Options{})
+	if err != nil {
+		t.Fatalf("Open: %s", faults.ErrorOf(err))
+	}
+
+	err = j.Close()
+	if err != nil {
+		t.Fatalf("Close: %s", faults.ErrorOf(err))
+	}
+
+	for name, fn := range map[string]func() flt.Fault{
+		"Append": func() flt.Fault { return j.Append(faults.NewBadParameter("x")) },
+		"Rotate": j.Rotate,
+	} {
+		err := fn()
+		if err == nil {
+			t.Errorf("%s: want a fault, got nil", name)
+		}
+	}
+}
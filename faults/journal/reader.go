@@ -0,0 +1,325 @@
+package journal
+
+import (
+	"bufio"
+	"compress/gzip"
+	"encoding/json"
+	"io"
+	"iter"
+	"os"
+	"slices"
+	"strings"
+	"time"
+
+	flt "github.com/PlayerR9/go-fault"
+	"github.com/PlayerR9/go-fault/faults"
+)
+
+const (
+	// maxLineSize is the maximum size, in bytes, of a line of a journal.
+	maxLineSize int = 16 << 20
+)
+
+// Entry is an entry of a journal.
+type Entry struct {
+	// Record is the record of the fault.
+	Record faults.Record
+
+	// Repeated is the number of occurrences that the entry stands for, when it is the
+	// summary of a faults.Reporter. Zero otherwise.
+	Repeated int
+
+	// FirstSeen is the time of the first occurrence that the summary stands for. The zero
+	// value if the entry is not a summary.
+	FirstSeen time.Time
+
+	// LastSeen is the time of the last occurrence that the summary stands for. The zero
+	// value if the entry is not a summary.
+	LastSeen time.Time
+}
+
+// Occurrences returns the number of occurrences of the fault that the entry stands for.
+//
+// Returns:
+//   - int: The number of occurrences. At least 1.
+func (e Entry) Occurrences() int {
+	return max(e.Repeated, 1)
+}
+
+// entryJSON is the JSON encoding of an Entry.
+type entryJSON struct {
+	faults.Record
+
+	// Repeated is the number of occurrences that the summary stands for.
+	Repeated int `json:"repeated,omitempty"`
+
+	// FirstSeen is the time of the first occurrence that the summary stands for.
+	FirstSeen time.Time `json:"first_seen,omitempty"`
+
+	// LastSeen is the time of the last occurrence that the summary stands for.
+	LastSeen time.Time `json:"last_seen,omitempty"`
+}
+
+// Filter selects the entries of a journal. The zero value selects every entry.
+type Filter struct {
+	// MinLevel, if not nil, selects the entries at least as severe.
+	MinLevel *flt.FaultLevel
+
+	// Levels, if not empty, selects the entries of these levels.
+	Levels []flt.FaultLevel
+
+	// Codes, if not empty, selects the entries whose code has one of these names.
+	Codes []string
+
+	// Since, if not zero, selects the entries that occurred at or after it.
+	Since time.Time
+
+	// Until, if not zero, selects the entries that occurred before it.
+	Until time.Time
+
+	// Query, if not nil, selects the entries whose restored fault it selects. (See
+	// faults.ParseQuery.)
+	Query *faults.Query
+}
+
+// Match checks whether the filter selects the record.
+//
+// Parameters:
+//   - record: The record to check.
+//
+// Returns:
+//   - bool: True if the filter selects the record, false otherwise.
+func (f Filter) Match(record faults.Record) bool {
+	if f.MinLevel != nil || len(f.Levels) > 0 {
+		level, err := flt.ParseLevel(record.Level)
+		if err != nil {
+			return false
+		}
+
+		if f.MinLevel != nil && !level.AtLeast(*f.MinLevel) {
+			return false
+		}
+
+		if len(f.Levels) > 0 && !slices.Contains(f.Levels, level) {
+			return false
+		}
+	}
+
+	if len(f.Codes) > 0 && !slices.Contains(f.Codes, record.Code) {
+		return false
+	}
+
+	if !f.Since.IsZero() && record.Timestamp.Before(f.Since) {
+		return false
+	}
+
+	if !f.Until.IsZero() && !record.Timestamp.Before(f.Until) {
+		return false
+	}
+
+	if f.Query != nil && !f.Query.Matches(record.Fault()) {
+		return false
+	}
+
+	return true
+}
+
+// Reader reads the entries of a journal; or of any stream of JSON lines of faults.
+type Reader struct {
+	// paths are the paths of the segments to read. Ignored if stream is not nil.
+	paths []string
+
+	// stream is the stream to read.
+	stream io.Reader
+
+	// filter selects the entries.
+	filter Filter
+
+	// err is the first fault that occurred while reading.
+	err flt.Fault
+}
+
+// NewReader creates a reader of the journal whose current segment is at path. All the
+// segments are read, from the oldest to the current one, and compressed segments are
+// decompressed on the fly.
+//
+// Parameters:
+//   - path: The path of the current segment.
+//   - filter: The filter that selects the entries.
+//
+// Returns:
+//   - *Reader: The new reader. Never returns nil.
+//   - flt.Fault: The fault that occurred while listing the segments, if any.
+func NewReader(path string, filter Filter) (*Reader, flt.Fault) {
+	segments, err := Segments(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Reader{
+		paths:  segments,
+		filter: filter,
+	}, nil
+}
+
+// NewStreamReader creates a reader of a stream of JSON lines of faults. (e.g., os.Stdin)
+//
+// Parameters:
+//   - r: The stream to read.
+//   - filter: The filter that selects the entries.
+//
+// Returns:
+//   - *Reader: The new reader. Never returns nil.
+func NewStreamReader(r io.Reader, filter Filter) *Reader {
+	if r == nil {
+		r = strings.NewReader("")
+	}
+
+	return &Reader{
+		stream: r,
+		filter: filter,
+	}
+}
+
+// Err returns the first fault that occurred while reading. Malformed lines do not stop
+// the iteration, but they are reported here.
+//
+// Returns:
+//   - flt.Fault: The first fault that occurred, if any.
+func (r *Reader) Err() flt.Fault {
+	return r.err
+}
+
+// fail records the fault if it is the first one.
+//
+// Parameters:
+//   - err: The fault to record.
+func (r *Reader) fail(err flt.Fault) {
+	if r.err == nil {
+		r.err = err
+	}
+}
+
+// Entries iterates over the selected entries.
+//
+// Returns:
+//   - iter.Seq[Entry]: The iterator over the entries.
+func (r *Reader) Entries() iter.Seq[Entry] {
+	return func(yield func(Entry) bool) {
+		if r.stream != nil {
+			r.scan(r.stream, yield)
+
+			return
+		}
+
+		for _, path := range r.paths {
+			ok := r.scanFile(path, yield)
+			if !ok {
+				return
+			}
+		}
+	}
+}
+
+// All iterates over the faults of the selected entries. (See faults.Record.Fault.)
+//
+// Returns:
+//   - iter.Seq[flt.Fault]: The iterator over the faults.
+func (r *Reader) All() iter.Seq[flt.Fault] {
+	return func(yield func(flt.Fault) bool) {
+		for entry := range r.Entries() {
+			if !yield(entry.Record.Fault()) {
+				return
+			}
+		}
+	}
+}
+
+// scanFile scans the segment at path.
+//
+// Parameters:
+//   - path: The path of the segment.
+//   - yield: The function to yield the entries to.
+//
+// Returns:
+//   - bool: False if the iteration was stopped, true otherwise.
+func (r *Reader) scanFile(path string, yield func(Entry) bool) bool {
+	file, err := os.Open(path)
+	if os.IsNotExist(err) {
+		return true
+	} else if err != nil {
+		r.fail(faults.FromErrWithMsg(err, "could not open the journal's segment"))
+
+		return true
+	}
+
+	defer file.Close()
+
+	var src io.Reader = file
+
+	if strings.HasSuffix(path, gzipExt) {
+		zr, err := gzip.NewReader(file)
+		if err != nil {
+			r.fail(faults.FromErrWithMsg(err, "could not decompress the journal's segment"))
+
+			return true
+		}
+
+		defer zr.Close()
+
+		src = zr
+	}
+
+	return r.scan(src, yield)
+}
+
+// scan scans a stream of JSON lines.
+//
+// Parameters:
+//   - src: The stream to scan.
+//   - yield: The function to yield the entries to.
+//
+// Returns:
+//   - bool: False if the iteration was stopped, true otherwise.
+func (r *Reader) scan(src io.Reader, yield func(Entry) bool) bool {
+	scanner := bufio.NewScanner(src)
+	scanner.Buffer(make([]byte, 0, 64*1024), maxLineSize)
+
+	for scanner.Scan() {
+		line := scanner.Bytes()
+		if len(strings.TrimSpace(string(line))) == 0 {
+			continue
+		}
+
+		var tmp entryJSON
+
+		err := json.Unmarshal(line, &tmp)
+		if err != nil {
+			r.fail(faults.FromErrWithMsg(err, "could not decode a journal's entry"))
+
+			continue
+		}
+
+		if !r.filter.Match(tmp.Record) {
+			continue
+		}
+
+		entry := Entry{
+			Record:    tmp.Record,
+			Repeated:  tmp.Repeated,
+			FirstSeen: tmp.FirstSeen,
+			LastSeen:  tmp.LastSeen,
+		}
+
+		if !yield(entry) {
+			return false
+		}
+	}
+
+	err := scanner.Err()
+	if err != nil {
+		r.fail(faults.FromErrWithMsg(err, "could not read the journal"))
+	}
+
+	return true
+}
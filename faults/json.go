@@ -0,0 +1,417 @@
+package faults
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"time"
+
+	flt "github.com/PlayerR9/go-fault"
+)
+
+// Layer is a layer of a fault's embedding tower, other than its base, as recorded in a
+// Record.
+type Layer struct {
+	// Type is the Go type of the layer. (e.g., "*faults.ErrFault")
+	Type string `json:"type"`
+
+	// Info is the additional information of the layer.
+	Info []string `json:"info,omitempty"`
+}
+
+// Record is the serializable form of a fault. It is the schema of the JSON encoding of
+// faults.
+type Record struct {
+	// Level is the name of the level of the fault.
+	Level string `json:"level"`
+
+	// Code is the string representation of the code of the fault.
+	Code string `json:"code"`
+
+	// CodeType is the Go type of the code of the fault. (e.g., "fault.StandardCode")
+	CodeType string `json:"code_type,omitempty"`
+
+	// CodeValue is the integer value of the code of the fault.
+	CodeValue int `json:"code_value"`
+
+	// Message is the rendered message of the fault.
+	Message string `json:"message"`
+
+	// Template is the message template of the descriptor of the fault. Empty if the message
+	// is not a template. (See flt.NewTemplateDescriptor.)
+	Template string `json:"template,omitempty"`
+
+	// Timestamp is the time when the fault occurred.
+	Timestamp time.Time `json:"timestamp"`
+
+	// Fingerprint is the fingerprint of the fault. (See Fingerprint.)
+	Fingerprint string `json:"fingerprint,omitempty"`
+
+	// Suggestions are the suggestions of the fault.
+	Suggestions []string `json:"suggestions,omitempty"`
+
+	// StackTrace is the stack trace of the fault, from the first frame added to the last.
+	StackTrace []string `json:"stack_trace,omitempty"`
+
+	// Context is the context of the fault. Values that cannot be encoded in JSON are
+	// recorded with their "%v" representation.
+	Context map[string]any `json:"context,omitempty"`
+
+	// Layers are the layers of the embedding tower above the base, from the innermost
+	// to the outermost.
+	Layers []Layer `json:"layers,omitempty"`
+
+	// Cause is the record of the cause of the fault, if any. (See Wrap.)
+	Cause *Record `json:"cause,omitempty"`
+
+	// Children are the records of the faults joined by the fault. (See Join.)
+	Children []Record `json:"children,omitempty"`
+}
+
+// RecordOf returns the serializable form of the fault. Sensitive values are redacted.
+//
+// Parameters:
+//   - fault: The fault to record.
+//
+// Returns:
+//   - Record: The record of the fault. The zero value if the fault is nil.
+func RecordOf(fault flt.Fault) Record {
+	return recordOf(fault, false)
+}
+
+// UnredactedRecordOf is like RecordOf but the sensitive values are recorded as-is. It
+// should never be used for logs.
+//
+// Parameters:
+//   - fault: The fault to record.
+//
+// Returns:
+//   - Record: The record of the fault. The zero value if the fault is nil.
+func UnredactedRecordOf(fault flt.Fault) Record {
+	return recordOf(fault, true)
+}
+
+// recordOf is the helper function of RecordOf and UnredactedRecordOf.
+//
+// Parameters:
+//   - fault: The fault to record.
+//   - show: Whether sensitive values are recorded as-is.
+//
+// Returns:
+//   - Record: The record of the fault. The zero value if the fault is nil.
+func recordOf(fault flt.Fault, show bool) Record {
+	var record Record
+
+	if fault == nil {
+		return record
+	}
+
+	base, ok := Access[*flt.BaseFault](fault)
+	if !ok {
+		panic(flt.BadConstruction.Init())
+	}
+
+	desc := base.Descriptor()
+	code := desc.Code()
+
+	record.Level = desc.Level().String()
+	record.Code = code.String()
+	record.CodeType, record.CodeValue = codeTypeOf(code)
+	record.Message = expandOf(fault, desc, desc.Message(), show)
+
+	if flt.IsTemplate(desc) {
+		record.Template = desc.Message()
+	}
+	record.Timestamp = base.Timestamp()
+	record.Fingerprint = Fingerprint(fault)
+	record.Suggestions = base.Suggestions()
+	record.StackTrace = base.StackTrace()
+
+	keys := base.Keys()
+	if len(keys) > 0 {
+		record.Context = make(map[string]any, len(keys))
+
+		for _, key := range keys {
+			value, _ := base.DisplayValue(key, show)
+
+			_, err := json.Marshal(value)
+			if err != nil {
+				value = fmt.Sprintf("%v", value)
+			}
+
+			record.Context[key] = value
+		}
+	}
+
+	for _, elem := range flt.EmbeddingTower(fault) {
+		switch elem := elem.(type) {
+		case *flt.BaseFault:
+			// Already recorded.
+		case *WrapFault:
+			record.addCause(elem.cause, show)
+		case *JoinFault:
+			record.addChildren(elem.faults, show)
+		case *RecordedFault:
+			record.Layers = append(record.Layers, elem.layers...)
+		default:
+			var info []string
+
+			if show {
+				info = flt.InfoLinesIn(elem, flt.Unredacted(nil))
+			} else {
+				info = elem.InfoLines()
+			}
+
+			record.Layers = append(record.Layers, Layer{
+				Type: reflect.TypeOf(elem).String(),
+				Info: info,
+			})
+
+			switch elem := elem.(type) {
+			case interface{ Unwrap() flt.Fault }:
+				record.addCause(elem.Unwrap(), show)
+			case interface{ Unwrap() []flt.Fault }:
+				record.addChildren(elem.Unwrap(), show)
+			}
+		}
+	}
+
+	return record
+}
+
+// addCause records the cause of the fault. As the embedding tower is recorded from the
+// innermost layer, the outermost cause replaces the others; as for Walk.
+//
+// Parameters:
+//   - cause: The cause. Does nothing if nil.
+//   - show: Whether sensitive values are recorded as-is.
+func (r *Record) addCause(cause flt.Fault, show bool) {
+	if cause == nil {
+		return
+	}
+
+	tmp := recordOf(cause, show)
+	r.Cause = &tmp
+}
+
+// addChildren records the faults joined by the fault.
+//
+// Parameters:
+//   - children: The joined faults. Nil faults are skipped.
+//   - show: Whether sensitive values are recorded as-is.
+func (r *Record) addChildren(children []flt.Fault, show bool) {
+	for _, child := range children {
+		if child != nil {
+			r.Children = append(r.Children, recordOf(child, show))
+		}
+	}
+}
+
+// codeTypeOf returns the Go type and the integer value of a code.
+//
+// Parameters:
+//   - code: The code.
+//
+// Returns:
+//   - string: The Go type of the code.
+//   - int: The integer value of the code.
+func codeTypeOf(code fmt.Stringer) (string, int) {
+	rc, ok := code.(flt.RecordedCode)
+	if ok {
+		return rc.Type, rc.Value
+	}
+
+	value := reflect.ValueOf(code)
+
+	switch value.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return value.Type().String(), int(value.Int())
+	default:
+		return value.Type().String(), 0
+	}
+}
+
+// RecordedFault is a fault restored from a Record. Its base holds the descriptor,
+// timestamp, suggestions, stack trace and context of the original fault while the
+// additional information of the original layers is kept as text.
+type RecordedFault struct {
+	flt.Fault
+
+	// layers are the recorded layers of the original fault.
+	layers []Layer
+
+	// fingerprint is the fingerprint of the original fault.
+	fingerprint string
+}
+
+// Embeds implements the flt.Fault interface.
+func (rf RecordedFault) Embeds() flt.Fault {
+	return rf.Fault
+}
+
+// InfoLines implements the flt.Fault interface.
+//
+// The lines are the additional information of the recorded layers; in order.
+func (rf RecordedFault) InfoLines() []string {
+	var lines []string
+
+	for _, layer := range rf.layers {
+		lines = append(lines, layer.Info...)
+	}
+
+	return lines
+}
+
+// Layers returns the recorded layers of the original fault.
+//
+// Returns:
+//   - []Layer: The recorded layers, from the innermost to the outermost.
+func (rf RecordedFault) Layers() []Layer {
+	return rf.layers
+}
+
+// Fingerprint returns the fingerprint of the original fault.
+//
+// Returns:
+//   - string: The fingerprint of the original fault.
+func (rf RecordedFault) Fingerprint() string {
+	return rf.fingerprint
+}
+
+// Fault restores the fault of the record. The code of the restored fault is a
+// flt.RecordedCode and levels that are not known are restored as flt.UnknownLevel.
+//
+// The cause and the children of the record are restored too; so that the restored fault
+// can be unwrapped, walked and queried as the original one. (See Walk.)
+//
+// Returns:
+//   - flt.Fault: The restored fault. Never returns nil.
+func (r Record) Fault() flt.Fault {
+	level, _ := flt.ParseLevel(r.Level)
+
+	code := flt.RecordedCode{
+		Type:  r.CodeType,
+		Name:  r.Code,
+		Value: r.CodeValue,
+	}
+
+	desc := flt.NewRecordedDescriptor(level, code, r.Message)
+	if r.Template != "" {
+		desc = flt.NewRecordedTemplateDescriptor(level, code, r.Template)
+	}
+
+	base := flt.Restore(desc, r.Timestamp)
+
+	_ = base.AddSuggestions(r.Suggestions...)
+
+	for _, frame := range r.StackTrace {
+		_ = base.AppendFrame(frame)
+	}
+
+	for key, value := range r.Context {
+		_ = base.SetKey(key, value)
+	}
+
+	var inner flt.Fault = base
+
+	if len(r.Children) > 0 {
+		children := make([]flt.Fault, 0, len(r.Children))
+
+		for _, child := range r.Children {
+			children = append(children, child.Fault())
+		}
+
+		inner = &JoinFault{
+			Fault:  base,
+			faults: children,
+		}
+	}
+
+	if r.Cause != nil {
+		inner = &WrapFault{
+			Fault: inner,
+			cause: r.Cause.Fault(),
+		}
+	}
+
+	return &RecordedFault{
+		Fault:       inner,
+		layers:      r.Layers,
+		fingerprint: r.Fingerprint,
+	}
+}
+
+// EncodeJSON encodes the fault in JSON. (See Record.) Sensitive values are redacted.
+//
+// Parameters:
+//   - fault: The fault to encode.
+//
+// Returns:
+//   - []byte: The JSON encoding of the fault. "null" if the fault is nil.
+//   - flt.Fault: The fault that occurred while encoding, if any.
+func EncodeJSON(fault flt.Fault) ([]byte, flt.Fault) {
+	if fault == nil {
+		return []byte("null"), nil
+	}
+
+	data, err := json.Marshal(RecordOf(fault))
+	if err != nil {
+		return nil, FromErrWithMsg(err, "could not encode the fault in JSON")
+	}
+
+	return data, nil
+}
+
+// WriteJSON writes the JSON encoding of the fault as a single line. (See EncodeJSON.)
+//
+// Parameters:
+//   - w: The writer to write to.
+//   - fault: The fault to write. Does nothing if nil.
+//
+// Returns:
+//   - flt.Fault: The fault that occurred while writing, if any.
+func WriteJSON(w io.Writer, fault flt.Fault) flt.Fault {
+	if fault == nil {
+		return nil
+	} else if w == nil {
+		return NewNilParameter("w")
+	}
+
+	data, err := EncodeJSON(fault)
+	if err != nil {
+		return err
+	}
+
+	data = append(data, '\n')
+
+	_, e := w.Write(data)
+	if e != nil {
+		return FromErrWithMsg(e, "could not write the fault")
+	}
+
+	return nil
+}
+
+// DecodeJSON decodes a fault from its JSON encoding. (See Record.Fault.)
+//
+// Parameters:
+//   - data: The JSON encoding of the fault.
+//
+// Returns:
+//   - flt.Fault: The decoded fault. Nil if the data is "null".
+//   - flt.Fault: The fault that occurred while decoding, if any.
+func DecodeJSON(data []byte) (flt.Fault, flt.Fault) {
+	var record *Record
+
+	err := json.Unmarshal(data, &record)
+	if err != nil {
+		return nil, FromErrWithMsg(err, "could not decode the fault from JSON")
+	}
+
+	if record == nil {
+		return nil, nil
+	}
+
+	return record.Fault(), nil
+}
@@ -0,0 +1,103 @@
+package faults_test
+
+import (
+	"slices"
+	"testing"
+
+	flt "github.com/PlayerR9/go-fault"
+	"github.com/PlayerR9/go-fault/faults"
+)
+
+// roundTrip encodes the fault in JSON and decodes it back.
+func roundTrip(tb testing.TB, fault flt.Fault) flt.Fault {
+	tb.Helper()
+
+	data, err := faults.EncodeJSON(fault)
+	if err != nil {
+		tb.Fatalf("EncodeJSON: %s", faults.ErrorOf(err))
+	}
+
+	got, err := faults.DecodeJSON(data)
+	if err != nil {
+		tb.Fatalf("DecodeJSON: %s", faults.ErrorOf(err))
+	}
+
+	return got
+}
+
+// messagesOf returns the messages of all the faults a fault leads to, in the order of Walk.
+func messagesOf(fault flt.Fault) []string {
+	var msgs []string
+
+	for f := range faults.All(fault) {
+		if _, ok := f.(*faults.RecordedFault); ok {
+			msgs = append(msgs, faults.MessageOf(f))
+		}
+	}
+
+	return msgs
+}
+
+func TestJSONRoundTrip(t *testing.T) {
+	tests := []struct {
+		name  string
+		fault flt.Fault
+		want  []string
+	}{
+		{
+			name:  "plain",
+			fault: faults.NewBadParameter("x must be positive"),
+			want:  []string{"x must be positive"},
+		},
+		{
+			name:  "cause",
+			fault: faults.Wrap(faults.NewBadParameter("x must be positive"), flt.OperationFailed, "could not parse"),
+			want:  []string{"could not parse", "x must be positive"},
+		},
+		{
+			name: "join",
+			fault: faults.Join(
+				faults.NewBadParameter("x must be positive"),
+				faults.NewBadParameter("y must be positive"),
+			),
+			want: []string{"joined 2 faults", "x must be positive", "y must be positive"},
+		},
+		{
+			name: "cause of a join",
+			fault: faults.Wrap(faults.Join(
+				faults.NewBadParameter("x must be positive"),
+				faults.Wrap(faults.NewBadParameter("y must be positive"), flt.OperationFailed, "inner"),
+			), flt.OperationFailed, "outer"),
+			want: []string{"outer", "joined 2 faults", "x must be positive", "inner", "y must be positive"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := messagesOf(roundTrip(t, tt.fault))
+
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestJSONRoundTripUnwrap(t *testing.T) {
+	cause := faults.NewBadParameter("x must be positive")
+
+	got := roundTrip(t, faults.Wrap(cause, flt.OperationFailed, "could not parse"))
+
+	inner := flt.Unwrap(got)
+	if inner == nil {
+		t.Fatal("Unwrap: got nil, want the cause")
+	}
+
+	if msg := faults.MessageOf(inner); msg != "x must be positive" {
+		t.Errorf("Unwrap: got %q, want %q", msg, "x must be positive")
+	}
+
+	if !faults.Match(got, faults.Code(flt.BadParameter)) {
+		t.Error("Match: the restored cause is not matched by its code")
+	}
+}
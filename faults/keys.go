@@ -0,0 +1,131 @@
+package faults
+
+import (
+	flt "github.com/PlayerR9/go-fault"
+)
+
+// Key is a typed key of a fault's context. Unlike plain string keys, the type of the
+// value is checked at compile time.
+//
+// Keys are stored in the context under their name; as such, the string API (AddKey,
+// ValueOf, ...) can still be used to access them dynamically.
+type Key[T any] struct {
+	// name is the name of the key in the context.
+	name string
+
+	// opts are the options applied whenever the key is set.
+	opts []KeyOption
+}
+
+// NewKey creates a new typed key.
+//
+// Parameters:
+//   - name: The name of the key in the context.
+//   - opts: The options applied whenever the key is set. (e.g., Sensitive())
+//
+// Returns:
+//   - Key[T]: The new key.
+//
+// Example:
+//
+//	var RequestID = faults.NewKey[string]("request_id")
+func NewKey[T any](name string, opts ...KeyOption) Key[T] {
+	return Key[T]{
+		name: name,
+		opts: opts,
+	}
+}
+
+// Name returns the name of the key in the context.
+//
+// Returns:
+//   - string: The name of the key.
+func (k Key[T]) Name() string {
+	return k.name
+}
+
+// String implements the fmt.Stringer interface.
+func (k Key[T]) String() string {
+	return k.name
+}
+
+// Set associates the value with the key in the fault's context; overwriting any previous
+// value.
+//
+// Parameters:
+//   - fault: The fault to set the key in.
+//   - key: The key to set.
+//   - value: The value to set.
+//
+// Returns:
+//   - bool: False if the fault is nil, true otherwise. A key with an empty name sets
+//     nothing but still reports true; just like AddKey.
+func Set[T any](fault flt.Fault, key Key[T], value T) bool {
+	return AddKey(fault, key.name, value, key.opts...)
+}
+
+// Get gets the value of the key from the fault's context.
+//
+// Parameters:
+//   - fault: The fault to get the value of the key from.
+//   - key: The key to get the value of.
+//
+// Returns:
+//   - T: The value of the key.
+//   - bool: True if the key exists and its value is of type T, false otherwise.
+func Get[T any](fault flt.Fault, key Key[T]) (T, bool) {
+	zero := *new(T)
+
+	if fault == nil {
+		return zero, false
+	}
+
+	base, ok := Access[*flt.BaseFault](fault)
+	if !ok {
+		panic(flt.BadConstruction.Init())
+	}
+
+	value, ok := base.Value(key.name)
+	if !ok {
+		return zero, false
+	}
+
+	v, ok := value.(T)
+	if !ok {
+		return zero, false
+	}
+
+	return v, true
+}
+
+// Has checks whether the key exists in the fault's context; regardless of the type of its
+// value.
+//
+// Parameters:
+//   - fault: The fault to check.
+//   - key: The key to look for.
+//
+// Returns:
+//   - bool: True if the key exists, false otherwise.
+func Has[T any](fault flt.Fault, key Key[T]) bool {
+	if fault == nil {
+		return false
+	}
+
+	base, ok := Access[*flt.BaseFault](fault)
+	if !ok {
+		panic(flt.BadConstruction.Init())
+	}
+
+	_, ok = base.Value(key.name)
+	return ok
+}
+
+// Delete deletes the key from the fault's context.
+//
+// Parameters:
+//   - fault: The fault to delete the key from.
+//   - key: The key to delete.
+func Delete[T any](fault flt.Fault, key Key[T]) {
+	DeleteKey(fault, key.name)
+}
@@ -0,0 +1,125 @@
+package faults_test
+
+import (
+	"slices"
+	"testing"
+
+	flt "github.com/PlayerR9/go-fault"
+	"github.com/PlayerR9/go-fault/faults"
+)
+
+func TestKey(t *testing.T) {
+	key := faults.NewKey[int]("attempts")
+
+	if got := key.Name(); got != "attempts" {
+		t.Errorf("Name: got %q, want %q", got, "attempts")
+	}
+
+	if got := key.String(); got != "attempts" {
+		t.Errorf("String: got %q, want %q", got, "attempts")
+	}
+}
+
+func TestSetGet(t *testing.T) {
+	attempts := faults.NewKey[int]("attempts")
+	unnamed := faults.NewKey[int]("")
+
+	tests := []struct {
+		name   string
+		fault  flt.Fault
+		key    faults.Key[int]
+		set    bool
+		want   int
+		wantOk bool
+	}{
+		{name: "set", fault: faults.NewBadParameter("x"), key: attempts, set: true, want: 3, wantOk: true},
+		{name: "embedded layer", fault: layer{Fault: faults.NewBadParameter("x")}, key: attempts, set: true, want: 3, wantOk: true},
+		{name: "unnamed key", fault: faults.NewBadParameter("x"), key: unnamed, set: true, want: 0, wantOk: false},
+		{name: "nil fault", fault: nil, key: attempts, set: false, want: 0, wantOk: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := faults.Set(tt.fault, tt.key, 3); got != tt.set {
+				t.Errorf("Set: got %t, want %t", got, tt.set)
+			}
+
+			got, ok := faults.Get(tt.fault, tt.key)
+			if ok != tt.wantOk || got != tt.want {
+				t.Errorf("Get: got %d and %t, want %d and %t", got, ok, tt.want, tt.wantOk)
+			}
+
+			if got := faults.Has(tt.fault, tt.key); got != tt.wantOk {
+				t.Errorf("Has: got %t, want %t", got, tt.wantOk)
+			}
+		})
+	}
+}
+
+func TestSetOverwrites(t *testing.T) {
+	key := faults.NewKey[string]("user")
+	fault := faults.NewBadParameter("x")
+
+	_ = faults.Set(fault, key, "ann")
+	_ = faults.Set(fault, key, "bob")
+
+	got, ok := faults.Get(fault, key)
+	if !ok || got != "bob" {
+		t.Errorf("got %q and %t, want %q and true", got, ok, "bob")
+	}
+}
+
+func TestGetTypeMismatch(t *testing.T) {
+	fault := faults.NewBadParameter("x")
+	_ = faults.AddKey(fault, "attempts", "three")
+
+	key := faults.NewKey[int]("attempts")
+
+	got, ok := faults.Get(fault, key)
+	if ok || got != 0 {
+		t.Errorf("got %d and %t, want 0 and false", got, ok)
+	}
+
+	// The key exists, regardless of the type of its value.
+	if !faults.Has(fault, key) {
+		t.Errorf("Has: got false, want true")
+	}
+
+	str, ok := faults.Get(fault, faults.NewKey[string]("attempts"))
+	if !ok || str != "three" {
+		t.Errorf("got %q and %t, want %q and true", str, ok, "three")
+	}
+}
+
+func TestDelete(t *testing.T) {
+	key := faults.NewKey[int]("attempts")
+	fault := faults.NewBadParameter("x")
+
+	_ = faults.Set(fault, key, 3)
+	faults.Delete(fault, key)
+
+	if faults.Has(fault, key) {
+		t.Errorf("got the key after its deletion, want it gone")
+	}
+
+	// Deleting from a nil fault or deleting a missing key does nothing.
+	faults.Delete(nil, key)
+	faults.Delete(fault, key)
+}
+
+func TestKeyOptions(t *testing.T) {
+	token := faults.NewKey[string]("token", faults.Sensitive())
+	fault := faults.NewBadParameter("x")
+
+	_ = faults.Set(fault, token, "s3cr3t")
+
+	lines := faults.LinesOf(fault)
+	if !slices.Contains(lines, "- token: "+flt.Redacted) {
+		t.Errorf("got %q, want the token redacted", lines)
+	}
+
+	got, ok := faults.Get(fault, token)
+	if !ok || got != "s3cr3t" {
+		t.Errorf("got %q and %t, want the raw value", got, ok)
+	}
+}
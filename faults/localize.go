@@ -0,0 +1,403 @@
+package faults
+
+import (
+	"slices"
+	"strings"
+	"sync"
+
+	flt "github.com/PlayerR9/go-fault"
+)
+
+const (
+	// DefaultLocale is the locale in which the texts of the library are written. It is
+	// always the last locale of a fallback chain.
+	DefaultLocale string = "en"
+)
+
+// Catalog is a set of translations of fault messages and texts, keyed by locale. It is
+// safe for concurrent use.
+//
+// DefaultCatalog has the translations of the texts of the library, of the shared
+// descriptors and of the default messages of the standard codes. (See StandardDescriptor.)
+// The messages that are given to constructors, such as NewBadParameter or NewNotFound, are
+// rendered as-is unless their translations are set with SetText.
+type Catalog struct {
+	// mu guards the catalog.
+	mu sync.RWMutex
+
+	// messages maps a locale to the translated messages of the descriptors.
+	messages map[string]map[flt.FaultDescriber]string
+
+	// texts maps a locale to the translations of free-standing texts such as suggestions
+	// and labels.
+	texts map[string]map[string]string
+}
+
+// NewCatalog creates a new, empty, catalog.
+//
+// Returns:
+//   - *Catalog: The new catalog. Never returns nil.
+func NewCatalog() *Catalog {
+	return &Catalog{
+		messages: make(map[string]map[flt.FaultDescriber]string),
+		texts:    make(map[string]map[string]string),
+	}
+}
+
+// SetMessage sets the translation of a descriptor's message in the given locale.
+//
+// Parameters:
+//   - locale: The locale of the translation. (e.g., "fr-CA")
+//   - desc: The descriptor whose message is translated.
+//   - msg: The translated message.
+//
+// Returns:
+//   - bool: True if the translation was set, false if the receiver or desc is nil.
+func (c *Catalog) SetMessage(locale string, desc flt.FaultDescriber, msg string) bool {
+	if c == nil || desc == nil {
+		return false
+	}
+
+	locale = NormalizeLocale(locale)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	table, ok := c.messages[locale]
+	if !ok {
+		table = make(map[flt.FaultDescriber]string)
+		c.messages[locale] = table
+	}
+
+	table[desc] = msg
+
+	return true
+}
+
+// SetText sets the translation of a free-standing text in the given locale. Texts are
+// used for suggestions, labels and the messages of descriptors that have no entry of
+// their own.
+//
+// Parameters:
+//   - locale: The locale of the translation. (e.g., "fr-CA")
+//   - text: The text, as written in the source code.
+//   - translation: The translated text.
+//
+// Returns:
+//   - bool: True if the translation was set, false if the receiver is nil.
+func (c *Catalog) SetText(locale, text, translation string) bool {
+	if c == nil {
+		return false
+	}
+
+	locale = NormalizeLocale(locale)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	table, ok := c.texts[locale]
+	if !ok {
+		table = make(map[string]string)
+		c.texts[locale] = table
+	}
+
+	table[text] = translation
+
+	return true
+}
+
+// Message returns the translation of a descriptor's message in exactly the given locale.
+//
+// Parameters:
+//   - locale: The locale of the translation.
+//   - desc: The descriptor whose message is translated.
+//
+// Returns:
+//   - string: The translated message.
+//   - bool: True if the translation exists, false otherwise.
+func (c *Catalog) Message(locale string, desc flt.FaultDescriber) (string, bool) {
+	if c == nil || desc == nil {
+		return "", false
+	}
+
+	locale = NormalizeLocale(locale)
+
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	msg, ok := c.messages[locale][desc]
+	return msg, ok
+}
+
+// Text returns the translation of a text in exactly the given locale.
+//
+// Parameters:
+//   - locale: The locale of the translation.
+//   - text: The text to translate.
+//
+// Returns:
+//   - string: The translated text.
+//   - bool: True if the translation exists, false otherwise.
+func (c *Catalog) Text(locale, text string) (string, bool) {
+	if c == nil {
+		return "", false
+	}
+
+	locale = NormalizeLocale(locale)
+
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	translation, ok := c.texts[locale][text]
+	return translation, ok
+}
+
+// Translations returns the translations of a text in all the locales of the catalog.
+//
+// Parameters:
+//   - text: The text to translate.
+//
+// Returns:
+//   - []string: The distinct translations, sorted. Nil if there are none.
+func (c *Catalog) Translations(text string) []string {
+	if c == nil {
+		return nil
+	}
+
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	var translations []string
+
+	for _, texts := range c.texts {
+		translation, ok := texts[text]
+		if ok {
+			translations = append(translations, translation)
+		}
+	}
+
+	slices.Sort(translations)
+
+	return slices.Compact(translations)
+}
+
+var (
+	// DefaultCatalog is the catalog used when no catalog is specified. It contains the
+	// translations of the library's own messages and texts.
+	//
+	// It is initialized at declaration so that the init functions of the package can
+	// register their entries regardless of the order in which they run.
+	DefaultCatalog *Catalog = NewCatalog()
+)
+
+// NormalizeLocale normalizes a locale tag so that "fr_CA", "FR-ca" and "fr-CA" are
+// all treated as the same locale.
+//
+// Parameters:
+//   - locale: The locale to normalize.
+//
+// Returns:
+//   - string: The normalized locale, in lower-case and with "-" as separator.
+func NormalizeLocale(locale string) string {
+	locale = strings.TrimSpace(locale)
+	locale = strings.ReplaceAll(locale, "_", "-")
+
+	return strings.ToLower(locale)
+}
+
+// FallbackChain returns the locales to try, in order, when looking up a translation in
+// the given locale. Each subtag is dropped in turn and DefaultLocale comes last.
+//
+// Parameters:
+//   - locale: The requested locale.
+//
+// Returns:
+//   - []string: The fallback chain. (e.g., "fr-CA" gives ["fr-ca", "fr", "en"])
+func FallbackChain(locale string) []string {
+	locale = NormalizeLocale(locale)
+
+	var chain []string
+
+	for locale != "" {
+		chain = append(chain, locale)
+
+		idx := strings.LastIndexByte(locale, '-')
+		if idx < 0 {
+			break
+		}
+
+		locale = locale[:idx]
+	}
+
+	if !slices.Contains(chain, DefaultLocale) {
+		chain = append(chain, DefaultLocale)
+	}
+
+	return chain
+}
+
+// Localizer renders faults in a requested locale.
+type Localizer struct {
+	// catalog is the catalog of translations.
+	catalog *Catalog
+
+	// chain is the fallback chain of locales.
+	chain []string
+
+	// unredacted tells whether sensitive values are rendered unredacted.
+	unredacted bool
+}
+
+// NewLocalizer creates a new Localizer.
+//
+// Parameters:
+//   - catalog: The catalog of translations. If nil, DefaultCatalog is used.
+//   - locales: The requested locales, in order of preference. Each one is expanded with
+//     FallbackChain.
+//
+// Returns:
+//   - *Localizer: The new Localizer. Never returns nil.
+func NewLocalizer(catalog *Catalog, locales ...string) *Localizer {
+	if catalog == nil {
+		catalog = DefaultCatalog
+	}
+
+	var chain []string
+
+	for _, locale := range locales {
+		for _, elem := range FallbackChain(locale) {
+			if elem != DefaultLocale && !slices.Contains(chain, elem) {
+				chain = append(chain, elem)
+			}
+		}
+	}
+
+	chain = append(chain, DefaultLocale)
+
+	return &Localizer{
+		catalog: catalog,
+		chain:   chain,
+	}
+}
+
+// Locales returns the fallback chain of the localizer.
+//
+// Returns:
+//   - []string: The locales that are tried, in order.
+func (l Localizer) Locales() []string {
+	return slices.Clone(l.chain)
+}
+
+// Unredacted returns a copy of the localizer that renders sensitive values unredacted.
+// It should never be used for logs.
+//
+// Returns:
+//   - *Localizer: The unredacted localizer. Never returns nil.
+func (l Localizer) Unredacted() *Localizer {
+	l.unredacted = true
+
+	return &l
+}
+
+// ShowSensitive implements the flt.SensitiveViewer interface.
+func (l Localizer) ShowSensitive() bool {
+	return l.unredacted
+}
+
+// Translate implements the flt.Translator interface.
+func (l Localizer) Translate(text string) string {
+	for _, locale := range l.chain {
+		translation, ok := l.catalog.Text(locale, text)
+		if ok {
+			return translation
+		}
+	}
+
+	return text
+}
+
+// MessageOf returns the message of the descriptor in the localizer's locale. Messages
+// registered for the descriptor take precedence over the texts that match the source
+// message.
+//
+// Parameters:
+//   - desc: The descriptor whose message is to be translated.
+//
+// Returns:
+//   - string: The translated message. Empty if desc is nil.
+func (l Localizer) MessageOf(desc flt.FaultDescriber) string {
+	if desc == nil {
+		return ""
+	}
+
+	for _, locale := range l.chain {
+		msg, ok := l.catalog.Message(locale, desc)
+		if ok {
+			return msg
+		}
+	}
+
+	return l.Translate(desc.Message())
+}
+
+// ErrorOf is like the ErrorOf function but the message is in the localizer's locale. The
+// placeholders of the translated message are filled from the fault's context if the
+// descriptor's message is a template.
+//
+// Parameters:
+//   - fault: The fault whose message is to be returned.
+//
+// Returns:
+//   - string: The message of the fault. Empty if the fault is nil.
+func (l Localizer) ErrorOf(fault flt.Fault) string {
+	desc := DescriptorOf(fault)
+	if desc == nil {
+		return ""
+	}
+
+	msg := expandOf(fault, desc, l.MessageOf(desc), l.unredacted)
+
+	return flt.FormatHeader(desc.Level(), desc.Code(), msg)
+}
+
+// LinesOf is like the LinesOf function but the fault is rendered in the localizer's
+// locale.
+//
+// Parameters:
+//   - fault: The fault to render.
+//
+// Returns:
+//   - []string: The lines of the fault.
+func (l Localizer) LinesOf(fault flt.Fault) []string {
+	if fault == nil {
+		return nil
+	}
+
+	var lines []string
+
+	lines = append(lines, l.ErrorOf(fault)+".")
+	lines = append(lines, "")
+
+	tmp := flt.InfoLinesIn(fault, l)
+	lines = append(lines, tmp...)
+
+	lines = appendCause(lines, fault, l.Translate("Caused by:"), l.LinesOf)
+
+	return lines
+}
+
+// translate translates the text with tr.
+//
+// Parameters:
+//   - tr: The translator to use. If nil, the text is returned as-is.
+//   - text: The text to translate.
+//
+// Returns:
+//   - string: The translated text.
+func translate(tr flt.Translator, text string) string {
+	if tr == nil {
+		return text
+	}
+
+	return tr.Translate(text)
+}
@@ -0,0 +1,103 @@
+package faults_test
+
+import (
+	"testing"
+
+	flt "github.com/PlayerR9/go-fault"
+	"github.com/PlayerR9/go-fault/faults"
+)
+
+func TestLocalizerStandardCodes(t *testing.T) {
+	catalog := faults.NewCatalog()
+	_ = catalog.SetText("fr", "no such user", "utilisateur inconnu")
+
+	tests := []struct {
+		name    string
+		catalog *faults.Catalog
+		locale  string
+		fault   flt.Fault
+		want    string
+	}{
+		{
+			name:   "default catalog",
+			locale: "fr",
+			fault:  faults.NewNotFound("no such user"),
+			want:   "[ERROR] (NotFound) no such user",
+		},
+		{
+			name:    "registered text",
+			catalog: catalog,
+			locale:  "fr",
+			fault:   faults.NewNotFound("no such user"),
+			want:    "[ERROR] (NotFound) utilisateur inconnu",
+		},
+		{
+			name:    "other locale",
+			catalog: catalog,
+			locale:  "es",
+			fault:   faults.NewNotFound("no such user"),
+			want:    "[ERROR] (NotFound) no such user",
+		},
+		{
+			name:   "default message",
+			locale: "fr",
+			fault:  faults.NewNotFound(""),
+			want:   "[ERROR] (NotFound) l'entité demandée est introuvable",
+		},
+		{
+			name:   "default message in another locale",
+			locale: "es-MX",
+			fault:  faults.NewDataLoss(""),
+			want:   "[FATAL] (DataLoss) se perdieron o corrompieron datos",
+		},
+		{
+			name:   "default message in an unknown locale",
+			locale: "de",
+			fault:  faults.NewCanceled(""),
+			want:   "[NOTICE] (Canceled) the operation was canceled",
+		},
+		{
+			name:    "registered text of another code",
+			catalog: catalog,
+			locale:  "fr-CA",
+			fault:   faults.NewDataLoss("no such user"),
+			want:    "[FATAL] (DataLoss) utilisateur inconnu",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := faults.NewLocalizer(tt.catalog, tt.locale).ErrorOf(tt.fault)
+
+			if got != tt.want {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestStandardDescriptors(t *testing.T) {
+	for _, code := range flt.StandardCodeValues() {
+		desc := faults.StandardDescriptor(code)
+		if desc == nil {
+			continue
+		}
+
+		t.Run(code.String(), func(t *testing.T) {
+			if got := desc.Code(); got != code {
+				t.Errorf("got the code %s, want %s", got, code)
+			}
+
+			if got := desc.Level(); got != code.Info().Level {
+				t.Errorf("got the level %s, want %s", got, code.Info().Level)
+			}
+
+			for _, locale := range []string{"fr", "es"} {
+				_, ok := faults.DefaultCatalog.Message(locale, desc)
+				if !ok {
+					t.Errorf("got no %s translation of %q, want one", locale, desc.Message())
+				}
+			}
+		})
+	}
+}
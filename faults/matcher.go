@@ -0,0 +1,329 @@
+package faults
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	flt "github.com/PlayerR9/go-fault"
+)
+
+// Matcher is a predicate over a single fault; such as a layer of an embedding tower, a
+// cause or a joined fault. Use Match to check a whole fault tree.
+//
+// A nil Matcher matches no fault.
+type Matcher func(fault flt.Fault) bool
+
+// And returns a matcher that matches the faults matched by m and by all the others.
+//
+// Parameters:
+//   - others: The other matchers.
+//
+// Returns:
+//   - Matcher: The new matcher. Never returns nil.
+func (m Matcher) And(others ...Matcher) Matcher {
+	return func(fault flt.Fault) bool {
+		if m == nil || !m(fault) {
+			return false
+		}
+
+		for _, other := range others {
+			if other == nil || !other(fault) {
+				return false
+			}
+		}
+
+		return true
+	}
+}
+
+// Or returns a matcher that matches the faults matched by m or by any of the others.
+//
+// Parameters:
+//   - others: The other matchers.
+//
+// Returns:
+//   - Matcher: The new matcher. Never returns nil.
+func (m Matcher) Or(others ...Matcher) Matcher {
+	return func(fault flt.Fault) bool {
+		if m != nil && m(fault) {
+			return true
+		}
+
+		for _, other := range others {
+			if other != nil && other(fault) {
+				return true
+			}
+		}
+
+		return false
+	}
+}
+
+// Not returns a matcher that matches the faults that m does not match.
+//
+// Parameters:
+//   - m: The matcher to negate.
+//
+// Returns:
+//   - Matcher: The new matcher. Never returns nil.
+func Not(m Matcher) Matcher {
+	return func(fault flt.Fault) bool {
+		return m == nil || !m(fault)
+	}
+}
+
+// Match checks whether a fault, or any fault it leads to, is matched by the matcher. The
+// faults are visited as by Walk; that is, the layers of the embedding towers, the causes
+// and the joined faults.
+//
+// Parameters:
+//   - fault: The fault to check.
+//   - m: The matcher.
+//
+// Returns:
+//   - bool: True if a fault is matched, false otherwise.
+//
+// Example:
+//
+//	ok := faults.Match(fault, faults.Code(flt.BadParameter).And(faults.LevelAtLeast(flt.ERROR)).Or(faults.HasKey("at")))
+func Match(fault flt.Fault, m Matcher) bool {
+	_, ok := Find(fault, m)
+	return ok
+}
+
+// Find is like Match but returns the first fault that is matched; in the order of Walk.
+//
+// Parameters:
+//   - fault: The fault to check.
+//   - m: The matcher.
+//
+// Returns:
+//   - flt.Fault: The first fault that is matched. Nil if none is.
+//   - bool: True if a fault is matched, false otherwise.
+func Find(fault flt.Fault, m Matcher) (flt.Fault, bool) {
+	if m == nil {
+		return nil, false
+	}
+
+	for f := range All(fault) {
+		if m(f) {
+			return f, true
+		}
+	}
+
+	return nil, false
+}
+
+// Code returns a matcher that matches the faults with the given code. Faults restored
+// from a Record match if their recorded code has the same type and name.
+//
+// Parameters:
+//   - code: The code.
+//
+// Returns:
+//   - Matcher: The matcher. Never returns nil.
+func Code[C flt.FaultCode](code C) Matcher {
+	type_name := fmt.Sprintf("%T", code)
+
+	return func(fault flt.Fault) bool {
+		got := DescriptorOf(fault).Code()
+		if got == fmt.Stringer(code) {
+			return true
+		}
+
+		rc, ok := got.(flt.RecordedCode)
+		return ok && rc.Name == code.String() && rc.Type == type_name
+	}
+}
+
+// Level returns a matcher that matches the faults of the given level.
+//
+// Parameters:
+//   - level: The level.
+//
+// Returns:
+//   - Matcher: The matcher. Never returns nil.
+func Level(level flt.FaultLevel) Matcher {
+	return func(fault flt.Fault) bool {
+		return LevelOf(fault) == level
+	}
+}
+
+// LevelAtLeast returns a matcher that matches the faults at least as severe as the given
+// level. (See flt.FaultLevel.AtLeast.)
+//
+// Parameters:
+//   - level: The level.
+//
+// Returns:
+//   - Matcher: The matcher. Never returns nil.
+func LevelAtLeast(level flt.FaultLevel) Matcher {
+	return func(fault flt.Fault) bool {
+		return LevelOf(fault).AtLeast(level)
+	}
+}
+
+// LevelAtMost returns a matcher that matches the faults at most as severe as the given
+// level.
+//
+// Parameters:
+//   - level: The level.
+//
+// Returns:
+//   - Matcher: The matcher. Never returns nil.
+func LevelAtMost(level flt.FaultLevel) Matcher {
+	return func(fault flt.Fault) bool {
+		return LevelOf(fault).Compare(level) <= 0
+	}
+}
+
+// HasKey returns a matcher that matches the faults whose context has the given key.
+//
+// Parameters:
+//   - key: The name of the key.
+//
+// Returns:
+//   - Matcher: The matcher. Never returns nil.
+func HasKey(key string) Matcher {
+	return hasKey(key, true)
+}
+
+// KeyValue returns a matcher that matches the faults whose context has the given key and
+// whose value satisfies the predicate. The predicate is given the raw value; even if it is
+// sensitive. (See flt.BaseFault.DisplayValue.)
+//
+// Parameters:
+//   - key: The name of the key.
+//   - pred: The predicate over the value. If nil, only the presence of the key is checked.
+//
+// Returns:
+//   - Matcher: The matcher. Never returns nil.
+func KeyValue(key string, pred func(value any) bool) Matcher {
+	return keyValue(key, pred, true)
+}
+
+// hasKey is like HasKey.
+//
+// Parameters:
+//   - key: The name of the key.
+//   - show: Whether sensitive values are looked up unredacted.
+//
+// Returns:
+//   - Matcher: The matcher. Never returns nil.
+func hasKey(key string, show bool) Matcher {
+	return keyValue(key, nil, show)
+}
+
+// keyValue is like KeyValue but the predicate is given the redacted value of sensitive
+// keys unless show is true.
+//
+// Parameters:
+//   - key: The name of the key.
+//   - pred: The predicate over the value. If nil, only the presence of the key is checked.
+//   - show: Whether sensitive values are given unredacted.
+//
+// Returns:
+//   - Matcher: The matcher. Never returns nil.
+func keyValue(key string, pred func(value any) bool, show bool) Matcher {
+	return func(fault flt.Fault) bool {
+		value, ok := valueOf(fault, key, show)
+		return ok && (pred == nil || pred(value))
+	}
+}
+
+// valueOf returns the value of a key of the fault's context.
+//
+// Parameters:
+//   - fault: The fault.
+//   - key: The name of the key.
+//   - show: Whether the value is returned unredacted if it is sensitive.
+//
+// Returns:
+//   - any: The value of the key.
+//   - bool: True if the key exists, false otherwise.
+func valueOf(fault flt.Fault, key string, show bool) (any, bool) {
+	base, ok := Access[*flt.BaseFault](fault)
+	if !ok {
+		panic(flt.BadConstruction.Init())
+	}
+
+	return base.DisplayValue(key, show)
+}
+
+// Descriptor returns a matcher that matches the faults created from the given descriptor.
+// Descriptors are compared by identity: faults created by distinct calls to flt.New never
+// share a descriptor.
+//
+// Parameters:
+//   - desc: The descriptor. (e.g., NoSuchKey)
+//
+// Returns:
+//   - Matcher: The matcher. Never returns nil.
+func Descriptor(desc flt.FaultDescriber) Matcher {
+	return func(fault flt.Fault) bool {
+		return desc != nil && DescriptorOf(fault) == desc
+	}
+}
+
+// Type returns a matcher that matches the faults of type T. Unlike Access, the embedding
+// tower of the fault is not scanned; Match visits its layers anyway.
+//
+// Returns:
+//   - Matcher: The matcher. Never returns nil.
+//
+// Example:
+//
+//	m := faults.Type[*faults.ErrNoSuchKey]()
+func Type[T flt.Fault]() Matcher {
+	return func(fault flt.Fault) bool {
+		_, ok := fault.(T)
+		return ok
+	}
+}
+
+// Trait returns a matcher that matches the faults with a layer, in their embedding tower,
+// that implements the interface I. (e.g., flt.LocalizedInfoer)
+//
+// Returns:
+//   - Matcher: The matcher. Never returns nil.
+func Trait[I any]() Matcher {
+	return func(fault flt.Fault) bool {
+		for ; fault != nil; fault = fault.Embeds() {
+			_, ok := fault.(I)
+			if ok {
+				return true
+			}
+		}
+
+		return false
+	}
+}
+
+// MessageMatches returns a matcher that matches the faults whose message, as given by
+// MessageOf, matches the regular expression.
+//
+// Parameters:
+//   - pattern: The regular expression. If nil, no fault is matched.
+//
+// Returns:
+//   - Matcher: The matcher. Never returns nil.
+func MessageMatches(pattern *regexp.Regexp) Matcher {
+	return func(fault flt.Fault) bool {
+		return pattern != nil && pattern.MatchString(MessageOf(fault))
+	}
+}
+
+// MessageContains returns a matcher that matches the faults whose message, as given by
+// MessageOf, contains the substring.
+//
+// Parameters:
+//   - substr: The substring.
+//
+// Returns:
+//   - Matcher: The matcher. Never returns nil.
+func MessageContains(substr string) Matcher {
+	return func(fault flt.Fault) bool {
+		return strings.Contains(MessageOf(fault), substr)
+	}
+}
@@ -0,0 +1,93 @@
+package faults_test
+
+import (
+	"testing"
+
+	flt "github.com/PlayerR9/go-fault"
+	"github.com/PlayerR9/go-fault/faults"
+)
+
+// layer is a fault that adds a layer, without methods of its own, on top of another one.
+type layer struct {
+	flt.Fault
+}
+
+func (l layer) Embeds() flt.Fault {
+	return l.Fault
+}
+
+func (l layer) InfoLines() []string {
+	return nil
+}
+
+func TestMatchers(t *testing.T) {
+	bad := faults.NewBadParameter("x must be positive")
+	key := faults.NewNoSuchKey("id")
+	wrapped := faults.Wrap(bad, flt.OperationFailed, "could not parse")
+
+	desc := flt.NewDescriptor(flt.ERROR, flt.BadParameter, "x must be positive")
+	first, second := flt.New(flt.BadParameter, "x"), flt.New(flt.BadParameter, "x")
+
+	yes := faults.Matcher(func(flt.Fault) bool { return true })
+	no := faults.Matcher(func(flt.Fault) bool { return false })
+
+	tests := []struct {
+		name  string
+		m     faults.Matcher
+		fault flt.Fault
+		want  bool
+	}{
+		{name: "and of all", m: yes.And(yes, yes), fault: bad, want: true},
+		{name: "and of none", m: yes.And(), fault: bad, want: true},
+		{name: "and with a mismatch", m: yes.And(yes, no), fault: bad, want: false},
+		{name: "and of a nil matcher", m: faults.Matcher(nil).And(yes), fault: bad, want: false},
+		{name: "and with a nil matcher", m: yes.And(nil), fault: bad, want: false},
+		{name: "or of a match", m: no.Or(no, yes), fault: bad, want: true},
+		{name: "or of none", m: no.Or(), fault: bad, want: false},
+		{name: "or of a nil matcher", m: faults.Matcher(nil).Or(yes), fault: bad, want: true},
+		{name: "or with a nil matcher", m: no.Or(nil), fault: bad, want: false},
+		{name: "not of a match", m: faults.Not(yes), fault: bad, want: false},
+		{name: "not of a mismatch", m: faults.Not(no), fault: bad, want: true},
+		{name: "not of a nil matcher", m: faults.Not(nil), fault: bad, want: true},
+		{
+			name:  "combination",
+			m:     faults.Code(flt.BadParameter).And(faults.Not(faults.HasKey("at"))).Or(faults.Level(flt.FATAL)),
+			fault: bad,
+			want:  true,
+		},
+		{name: "shared descriptor", m: faults.Descriptor(faults.NoSuchKey), fault: key, want: true},
+		{name: "other descriptor", m: faults.Descriptor(faults.NoSuchKey), fault: bad, want: false},
+		{name: "descriptor of Init", m: faults.Descriptor(desc), fault: desc.Init(), want: true},
+		{name: "descriptor with the same contents", m: faults.Descriptor(desc), fault: bad, want: false},
+		{name: "descriptor of the same New", m: faults.Descriptor(faults.DescriptorOf(first)), fault: first, want: true},
+		{name: "descriptors of distinct News", m: faults.Descriptor(faults.DescriptorOf(first)), fault: second, want: false},
+		{name: "nil descriptor", m: faults.Descriptor(nil), fault: bad, want: false},
+		{name: "type", m: faults.Type[*faults.ErrNoSuchKey](), fault: key, want: true},
+		{name: "other type", m: faults.Type[*faults.ErrNoSuchKey](), fault: bad, want: false},
+		{name: "type of an embedded layer", m: faults.Type[*flt.BaseFault](), fault: key, want: false},
+		{name: "trait", m: faults.Trait[interface{ Unwrap() flt.Fault }](), fault: wrapped, want: true},
+		{name: "trait of an embedded layer", m: faults.Trait[interface{ Unwrap() flt.Fault }](), fault: layer{Fault: wrapped}, want: true},
+		{name: "missing trait", m: faults.Trait[interface{ Unwrap() flt.Fault }](), fault: bad, want: false},
+		{name: "trait of nil", m: faults.Trait[flt.Fault](), fault: nil, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.m(tt.fault); got != tt.want {
+				t.Errorf("got %t, want %t", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMatchVisitsEmbeddedLayers(t *testing.T) {
+	key := faults.NewNoSuchKey("id")
+
+	if !faults.Match(key, faults.Type[*flt.BaseFault]()) {
+		t.Errorf("got no match, want the base of the fault to match")
+	}
+
+	if faults.Match(key, nil) {
+		t.Errorf("got a match of the nil matcher, want none")
+	}
+}
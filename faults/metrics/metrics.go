@@ -0,0 +1,259 @@
+// Package metrics counts faults by code, level and descriptor, and measures how long
+// faults take to be handled. The results are exported via expvar and in the Prometheus
+// text exposition format; without any third-party dependency.
+//
+// A typical setup looks like:
+//
+//	collector := metrics.NewCollector()
+//	defer collector.Attach(nil)()
+//
+//	collector.Publish("faults")
+//	http.Handle("/metrics", collector)
+//
+// and, wherever faults are finally reported (e.g., logged):
+//
+//	collector.Reported(fault)
+package metrics
+
+import (
+	"expvar"
+	"reflect"
+	"slices"
+	"sync"
+
+	flt "github.com/PlayerR9/go-fault"
+	"github.com/PlayerR9/go-fault/faults"
+)
+
+var (
+	// DefaultBuckets are the upper bounds, in seconds, of the buckets of the time-to-handle
+	// histograms when none are specified.
+	DefaultBuckets []float64 = []float64{0.001, 0.005, 0.01, 0.05, 0.1, 0.5, 1, 5, 10, 60}
+)
+
+// series identifies a counter of faults.
+type series struct {
+	// code is the code of the faults.
+	code string
+
+	// level is the level of the faults.
+	level string
+
+	// descriptor is the label of the descriptor of the faults. (See descriptorLabel.)
+	descriptor string
+}
+
+// names are the names of the shared descriptors of the faults package, by descriptor. The
+// shared descriptors of the standard codes are named after their code. (See
+// faults.StandardDescriptor.)
+var names map[flt.FaultDescriber]string
+
+func init() {
+	names = map[flt.FaultDescriber]string{
+		faults.NilReceiver:     "NilReceiver",
+		faults.NilParameter:    "NilParameter",
+		faults.NoSuchKey:       "NoSuchKey",
+		faults.IndexOutOfRange: "IndexOutOfRange",
+		faults.TypeMismatch:    "TypeMismatch",
+		faults.TimedOut:        "TimedOut",
+	}
+
+	for _, code := range flt.StandardCodeValues() {
+		desc := faults.StandardDescriptor(code)
+		if desc != nil {
+			names[desc] = code.String()
+		}
+	}
+}
+
+// descriptorLabel returns the label of the descriptor in the series. The labels are
+// bounded: the descriptors created per fault, whose messages embed the specifics, all
+// share the empty label.
+//
+// Parameters:
+//   - desc: The descriptor. Assumed to be non-nil.
+//
+// Returns:
+//   - string: The name of the descriptor if it is a shared descriptor of the faults
+//     package (e.g., "NoSuchKey" or "NotFound"), its template if it is a template (see
+//     flt.IsTemplate) and the empty string otherwise.
+func descriptorLabel(desc flt.FaultDescriber) string {
+	// Descriptors of non-comparable types would make the lookup panic.
+	if reflect.TypeOf(desc).Comparable() {
+		name, ok := names[desc]
+		if ok {
+			return name
+		}
+	}
+
+	if flt.IsTemplate(desc) {
+		return desc.Message()
+	}
+
+	return ""
+}
+
+// histogram is a cumulative histogram of durations, in seconds.
+type histogram struct {
+	// counts holds, for each bucket, the number of observations that fall in it. The last
+	// element is the +Inf bucket.
+	counts []uint64
+
+	// sum is the sum of all the observations.
+	sum float64
+
+	// count is the number of observations.
+	count uint64
+}
+
+// observe adds an observation to the histogram.
+//
+// Parameters:
+//   - bounds: The upper bounds of the buckets.
+//   - value: The observation.
+func (h *histogram) observe(bounds []float64, value float64) {
+	idx, _ := slices.BinarySearch(bounds, value)
+	h.counts[idx]++
+
+	h.sum += value
+	h.count++
+}
+
+// Collector counts faults and measures how long they take to be handled. It is safe for
+// concurrent use.
+type Collector struct {
+	// mu guards the fields below.
+	mu sync.Mutex
+
+	// bounds are the sorted upper bounds of the buckets of the histograms.
+	bounds []float64
+
+	// counts are the number of faults created, by series.
+	counts map[series]uint64
+
+	// handle are the time-to-handle histograms, by code.
+	handle map[string]*histogram
+}
+
+// NewCollector creates a new Collector.
+//
+// Parameters:
+//   - buckets: The upper bounds, in seconds, of the buckets of the time-to-handle
+//     histograms. If empty, DefaultBuckets is used.
+//
+// Returns:
+//   - *Collector: The new Collector. Never returns nil.
+func NewCollector(buckets ...float64) *Collector {
+	if len(buckets) == 0 {
+		buckets = DefaultBuckets
+	}
+
+	bounds := slices.Clone(buckets)
+	slices.Sort(bounds)
+	bounds = slices.Compact(bounds)
+
+	return &Collector{
+		bounds: bounds,
+		counts: make(map[series]uint64),
+		handle: make(map[string]*histogram),
+	}
+}
+
+// Attach makes the collector count every fault created while attached.
+//
+// Parameters:
+//   - hooks: The hooks to attach to. If nil, flt.GlobalHooks is used.
+//
+// Returns:
+//   - func(): The function that detaches the collector. Never returns nil.
+func (c *Collector) Attach(hooks *flt.Hooks) func() {
+	if hooks == nil {
+		hooks = flt.GlobalHooks
+	}
+
+	return hooks.OnCreate(c.Count)
+}
+
+// Count counts the fault. It is called for every created fault once the collector is
+// attached; as such, it only needs to be called directly for faults that are not
+// observed by the hooks.
+//
+// Parameters:
+//   - fault: The fault to count. Does nothing if nil.
+func (c *Collector) Count(fault flt.Fault) {
+	if c == nil || fault == nil {
+		return
+	}
+
+	desc := faults.DescriptorOf(fault)
+	if desc == nil {
+		return
+	}
+
+	key := series{
+		code:       desc.Code().String(),
+		level:      desc.Level().String(),
+		descriptor: descriptorLabel(desc),
+	}
+
+	c.mu.Lock()
+	c.counts[key]++
+	c.mu.Unlock()
+}
+
+// Reported records that the fault has been reported; measuring the time elapsed since
+// faults.TimestampOf according to the global clock. (See flt.SetClock.)
+//
+// Parameters:
+//   - fault: The fault that was reported. Does nothing if nil or if it has no timestamp.
+func (c *Collector) Reported(fault flt.Fault) {
+	if c == nil || fault == nil {
+		return
+	}
+
+	timestamp := faults.TimestampOf(fault)
+	if timestamp.IsZero() {
+		return
+	}
+
+	elapsed := flt.Now().Sub(timestamp).Seconds()
+	code := faults.DescriptorOf(fault).Code().String()
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	h, ok := c.handle[code]
+	if !ok {
+		h = &histogram{
+			counts: make([]uint64, len(c.bounds)+1),
+		}
+
+		c.handle[code] = h
+	}
+
+	h.observe(c.bounds, elapsed)
+}
+
+// Reset forgets all the counts and observations.
+func (c *Collector) Reset() {
+	if c == nil {
+		return
+	}
+
+	c.mu.Lock()
+	clear(c.counts)
+	clear(c.handle)
+	c.mu.Unlock()
+}
+
+// Publish publishes the collector's snapshot as an expvar variable.
+//
+// Parameters:
+//   - name: The name of the variable.
+//
+// Panics if the name is already in use; just like expvar.Publish.
+func (c *Collector) Publish(name string) {
+	expvar.Publish(name, expvar.Func(func() any {
+		return c.Snapshot()
+	}))
+}
@@ -0,0 +1,114 @@
+package metrics_test
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	flt "github.com/PlayerR9/go-fault"
+	"github.com/PlayerR9/go-fault/faults"
+	"github.com/PlayerR9/go-fault/faults/faulttest"
+	"github.com/PlayerR9/go-fault/faults/metrics"
+)
+
+func TestReported(t *testing.T) {
+	tests := []struct {
+		name    string
+		elapsed time.Duration
+		bucket  float64
+	}{
+		{name: "immediate", elapsed: 0, bucket: 0.001},
+		{name: "seconds", elapsed: 2 * time.Second, bucket: 5},
+		{name: "minutes", elapsed: 2 * time.Minute, bucket: -1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clock := faulttest.NewFakeClock(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC))
+			faulttest.UseClock(t, clock)
+
+			c := metrics.NewCollector()
+
+			fault := faults.NewBadParameter("x must be positive")
+			clock.Advance(tt.elapsed)
+			c.Reported(fault)
+
+			snapshot := c.Snapshot()
+			if len(snapshot.Handle) != 1 {
+				t.Fatalf("got %d histograms, want 1", len(snapshot.Handle))
+			}
+
+			h := snapshot.Handle[0]
+
+			if h.Sum != tt.elapsed.Seconds() {
+				t.Errorf("got a sum of %v, want %v", h.Sum, tt.elapsed.Seconds())
+			}
+
+			// The observation is counted by the buckets from its own onwards.
+			for _, b := range h.Buckets {
+				want := uint64(0)
+				if tt.bucket >= 0 && b.UpperBound >= tt.bucket {
+					want = 1
+				}
+
+				if b.Count != want {
+					t.Errorf("bucket le=%v: got %d, want %d", b.UpperBound, b.Count, want)
+				}
+			}
+		})
+	}
+}
+
+func TestWriteTo(t *testing.T) {
+	c := metrics.NewCollector()
+
+	for i := range 3 {
+		c.Count(faults.NewBadParameter(fmt.Sprintf("item %d must be positive", i)))
+	}
+
+	c.Count(faults.NewNotFound("no such user"))
+	c.Count(faults.NewNotFound(""))
+
+	c.Count(faults.NewNoSuchKey("a"))
+	c.Count(faults.NewNoSuchKey("b"))
+	c.Count(faults.NewNilParameter("cfg"))
+
+	desc := flt.NewTemplateDescriptor(flt.ERROR, flt.BadParameter, `{name} must be "positive"`)
+
+	for _, name := range []string{"x", "y"} {
+		fault := desc.Init()
+		_ = faults.AddKey(fault, "name", name)
+
+		c.Count(fault)
+	}
+
+	var b strings.Builder
+
+	_, err := c.WriteTo(&b)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []string{
+		`faults_total{code="BadParameter",level="ERROR",descriptor=""} 3`,
+		`faults_total{code="BadParameter",level="ERROR",descriptor="NilParameter"} 1`,
+		`faults_total{code="BadParameter",level="ERROR",descriptor="{name} must be \"positive\""} 2`,
+		`faults_total{code="NotFound",level="ERROR",descriptor=""} 1`,
+		`faults_total{code="NotFound",level="ERROR",descriptor="NotFound"} 1`,
+		`faults_total{code="OperationFailed",level="ERROR",descriptor="NoSuchKey"} 2`,
+	}
+
+	var got []string
+
+	for _, line := range strings.Split(b.String(), "\n") {
+		if strings.HasPrefix(line, "faults_total{") {
+			got = append(got, line)
+		}
+	}
+
+	if !slices.Equal(got, want) {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
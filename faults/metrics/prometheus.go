@@ -0,0 +1,131 @@
+package metrics
+
+import (
+	"bufio"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+const (
+	// ContentType is the content type of the Prometheus text exposition format.
+	ContentType string = "text/plain; version=0.0.4; charset=utf-8"
+)
+
+// labelEscaper escapes the values of the labels.
+var labelEscaper *strings.Replacer = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+// WriteTo writes the collector's metrics in the Prometheus text exposition format.
+//
+// The exposed metrics are:
+//   - faults_total: A counter of the created faults, labeled by code, level and
+//     descriptor.
+//   - fault_handle_seconds: A histogram of the time between the creation of a fault and
+//     its report, labeled by code.
+//
+// Parameters:
+//   - w: The writer to write to.
+//
+// Returns:
+//   - int64: The number of bytes written.
+//   - error: The error that occurred while writing, if any.
+func (c *Collector) WriteTo(w io.Writer) (int64, error) {
+	snapshot := c.Snapshot()
+
+	cw := &countingWriter{
+		w: w,
+	}
+
+	bw := bufio.NewWriter(cw)
+
+	bw.WriteString("# HELP faults_total Number of faults created, by code, level and descriptor.\n")
+	bw.WriteString("# TYPE faults_total counter\n")
+
+	for _, count := range snapshot.Counts {
+		bw.WriteString("faults_total{code=\"")
+		bw.WriteString(labelEscaper.Replace(count.Code))
+		bw.WriteString("\",level=\"")
+		bw.WriteString(labelEscaper.Replace(count.Level))
+		bw.WriteString("\",descriptor=\"")
+		bw.WriteString(labelEscaper.Replace(count.Descriptor))
+		bw.WriteString("\"} ")
+		bw.WriteString(strconv.FormatUint(count.Value, 10))
+		bw.WriteByte('\n')
+	}
+
+	bw.WriteString("# HELP fault_handle_seconds Time between the creation of a fault and its report.\n")
+	bw.WriteString("# TYPE fault_handle_seconds histogram\n")
+
+	for _, h := range snapshot.Handle {
+		code := labelEscaper.Replace(h.Code)
+
+		for _, bucket := range h.Buckets {
+			bw.WriteString("fault_handle_seconds_bucket{code=\"")
+			bw.WriteString(code)
+			bw.WriteString("\",le=\"")
+			bw.WriteString(strconv.FormatFloat(bucket.UpperBound, 'g', -1, 64))
+			bw.WriteString("\"} ")
+			bw.WriteString(strconv.FormatUint(bucket.Count, 10))
+			bw.WriteByte('\n')
+		}
+
+		bw.WriteString("fault_handle_seconds_bucket{code=\"")
+		bw.WriteString(code)
+		bw.WriteString("\",le=\"+Inf\"} ")
+		bw.WriteString(strconv.FormatUint(h.Count, 10))
+		bw.WriteByte('\n')
+
+		bw.WriteString("fault_handle_seconds_sum{code=\"")
+		bw.WriteString(code)
+		bw.WriteString("\"} ")
+		bw.WriteString(strconv.FormatFloat(h.Sum, 'g', -1, 64))
+		bw.WriteByte('\n')
+
+		bw.WriteString("fault_handle_seconds_count{code=\"")
+		bw.WriteString(code)
+		bw.WriteString("\"} ")
+		bw.WriteString(strconv.FormatUint(h.Count, 10))
+		bw.WriteByte('\n')
+	}
+
+	err := bw.Flush()
+
+	return cw.n, err
+}
+
+// ServeHTTP implements the http.Handler interface. It writes the collector's metrics in
+// the Prometheus text exposition format. (See WriteTo.)
+func (c *Collector) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		w.Header().Set("Allow", "GET, HEAD")
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+
+		return
+	}
+
+	w.Header().Set("Content-Type", ContentType)
+
+	if r.Method == http.MethodHead {
+		return
+	}
+
+	_, _ = c.WriteTo(w)
+}
+
+// countingWriter is an io.Writer that counts the bytes written.
+type countingWriter struct {
+	// w is the underlying writer.
+	w io.Writer
+
+	// n is the number of bytes written.
+	n int64
+}
+
+// Write implements the io.Writer interface.
+func (cw *countingWriter) Write(data []byte) (int, error) {
+	n, err := cw.w.Write(data)
+	cw.n += int64(n)
+
+	return n, err
+}
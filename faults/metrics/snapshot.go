@@ -0,0 +1,121 @@
+package metrics
+
+import (
+	"cmp"
+	"slices"
+)
+
+// Count is the number of faults created for a given code, level and descriptor.
+type Count struct {
+	// Code is the code of the faults.
+	Code string `json:"code"`
+
+	// Level is the level of the faults.
+	Level string `json:"level"`
+
+	// Descriptor is the name of the shared descriptor of the faults, or its template;
+	// empty for the descriptors created per fault.
+	Descriptor string `json:"descriptor"`
+
+	// Value is the number of faults.
+	Value uint64 `json:"value"`
+}
+
+// Bucket is a bucket of a cumulative histogram.
+type Bucket struct {
+	// UpperBound is the upper bound of the bucket, in seconds. The last bucket of a
+	// histogram has an infinite upper bound.
+	UpperBound float64 `json:"le"`
+
+	// Count is the number of observations that are less than or equal to UpperBound.
+	Count uint64 `json:"count"`
+}
+
+// Histogram is the time-to-handle histogram of the faults of a given code.
+type Histogram struct {
+	// Code is the code of the faults.
+	Code string `json:"code"`
+
+	// Buckets are the cumulative buckets of the histogram; without the +Inf bucket, whose
+	// count is Count.
+	Buckets []Bucket `json:"buckets"`
+
+	// Sum is the sum of all the observations, in seconds.
+	Sum float64 `json:"sum"`
+
+	// Count is the number of observations.
+	Count uint64 `json:"count"`
+}
+
+// Snapshot is a point-in-time copy of a collector's metrics.
+type Snapshot struct {
+	// Counts are the number of faults created; sorted by code, level and descriptor.
+	Counts []Count `json:"counts"`
+
+	// Handle are the time-to-handle histograms; sorted by code.
+	Handle []Histogram `json:"handle_seconds"`
+}
+
+// Snapshot returns a point-in-time copy of the collector's metrics.
+//
+// Returns:
+//   - Snapshot: The copy of the metrics.
+func (c *Collector) Snapshot() Snapshot {
+	var snapshot Snapshot
+
+	if c == nil {
+		return snapshot
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	snapshot.Counts = make([]Count, 0, len(c.counts))
+
+	for key, value := range c.counts {
+		snapshot.Counts = append(snapshot.Counts, Count{
+			Code:       key.code,
+			Level:      key.level,
+			Descriptor: key.descriptor,
+			Value:      value,
+		})
+	}
+
+	slices.SortFunc(snapshot.Counts, func(a, b Count) int {
+		return cmp.Or(
+			cmp.Compare(a.Code, b.Code),
+			cmp.Compare(a.Level, b.Level),
+			cmp.Compare(a.Descriptor, b.Descriptor),
+		)
+	})
+
+	snapshot.Handle = make([]Histogram, 0, len(c.handle))
+
+	for code, h := range c.handle {
+		buckets := make([]Bucket, 0, len(c.bounds))
+
+		var cumulative uint64
+
+		for i, bound := range c.bounds {
+			cumulative += h.counts[i]
+
+			buckets = append(buckets, Bucket{
+				UpperBound: bound,
+				Count:      cumulative,
+			})
+		}
+
+		snapshot.Handle = append(snapshot.Handle, Histogram{
+			Code:    code,
+			Buckets: buckets,
+			Sum:     h.sum,
+			Count:   h.count,
+		})
+	}
+
+	slices.SortFunc(snapshot.Handle, func(a, b Histogram) int {
+		return cmp.Compare(a.Code, b.Code)
+	})
+
+	return snapshot
+}
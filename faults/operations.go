@@ -32,9 +32,17 @@ func DescriptorOf(fault flt.Fault) flt.FaultDescriber {
 		panic(flt.BadConstruction.Init())
 	}
 
-	return base.descriptor
+	return base.Descriptor()
 }
 
+// ErrorOf returns the message of the fault; with the placeholders of the descriptor's
+// message filled from the fault's context if it is a template.
+//
+// Parameters:
+//   - fault: The fault whose message is to be returned.
+//
+// Returns:
+//   - string: The message of the fault. Empty if the fault is nil.
 func ErrorOf(fault flt.Fault) string {
 	if fault == nil {
 		return ""
@@ -48,6 +56,25 @@ func ErrorOf(fault flt.Fault) string {
 	return base.Error()
 }
 
+// MessageOf returns the message of the fault without its level and code; with the
+// placeholders of the descriptor's message filled from the fault's context if it is a
+// template. Sensitive values are redacted.
+//
+// Parameters:
+//   - fault: The fault whose message is to be returned.
+//
+// Returns:
+//   - string: The message of the fault. Empty if the fault is nil.
+func MessageOf(fault flt.Fault) string {
+	if fault == nil {
+		return ""
+	}
+
+	desc := DescriptorOf(fault)
+
+	return expandOf(fault, desc, desc.Message(), false)
+}
+
 func LevelOf(fault flt.Fault) flt.FaultLevel {
 	if fault == nil {
 		return flt.UnknownLevel
@@ -58,7 +85,7 @@ func LevelOf(fault flt.Fault) flt.FaultLevel {
 		panic(flt.BadConstruction.Init())
 	}
 
-	return base.descriptor.Level()
+	return base.Descriptor().Level()
 }
 
 func TimestampOf(fault flt.Fault) time.Time {
@@ -71,7 +98,31 @@ func TimestampOf(fault flt.Fault) time.Time {
 		panic(flt.BadConstruction.Init())
 	}
 
-	return base.timestamp
+	return base.Timestamp()
+}
+
+// KeyOption is an option that applies to a key of a fault's context.
+type KeyOption func(base *flt.BaseFault, key string)
+
+// Sensitive marks the key as sensitive; its values are rendered as flt.Redacted.
+//
+// Returns:
+//   - KeyOption: The option. Never returns nil.
+func Sensitive() KeyOption {
+	return func(base *flt.BaseFault, key string) {
+		_ = base.MarkSensitive(key, flt.RedactMask)
+	}
+}
+
+// SensitiveHashed marks the key as sensitive; its values are rendered as a stable hash so
+// that equal values can still be correlated.
+//
+// Returns:
+//   - KeyOption: The option. Never returns nil.
+func SensitiveHashed() KeyOption {
+	return func(base *flt.BaseFault, key string) {
+		_ = base.MarkSensitive(key, flt.RedactHash)
+	}
 }
 
 // AddKey adds a new key/value pair to the fault's context if key is not empty.
@@ -80,10 +131,11 @@ func TimestampOf(fault flt.Fault) time.Time {
 //   - fault: The fault to add the key/value pair to.
 //   - key: The key to add.
 //   - value: The value to add.
+//   - opts: The options of the key. (e.g., Sensitive())
 //
 // Returns:
 //   - bool: True if the key is empty or the fault is not nil, false otherwise.
-func AddKey(fault flt.Fault, key string, value any) bool {
+func AddKey(fault flt.Fault, key string, value any, opts ...KeyOption) bool {
 	if key == "" {
 		return true
 	}
@@ -97,15 +149,58 @@ func AddKey(fault flt.Fault, key string, value any) bool {
 		panic(flt.BadConstruction.Init())
 	}
 
-	if base.context == nil {
-		base.context = make(map[string]any)
-	}
+	base.SetKey(key, value)
 
-	base.context[key] = value
+	for _, opt := range opts {
+		opt(base, key)
+	}
 
 	return true
 }
 
+// expandOf returns the message of the fault's descriptor; with its placeholders filled
+// from the fault's context if it is a template. (See flt.NewTemplateDescriptor.)
+//
+// Parameters:
+//   - fault: The fault. Assumed to be non-nil.
+//   - desc: The descriptor of the fault.
+//   - msg: The message of the descriptor; possibly translated.
+//   - show: Whether sensitive values are filled unredacted.
+//
+// Returns:
+//   - string: The message.
+func expandOf(fault flt.Fault, desc flt.FaultDescriber, msg string, show bool) string {
+	if !flt.IsTemplate(desc) {
+		return msg
+	}
+
+	return flt.ExpandMessage(msg, lookupOf(fault, show))
+}
+
+// lookupOf returns a function that looks up the keys of the fault's context. It is
+// meant to be used with flt.ExpandMessage.
+//
+// Parameters:
+//   - fault: The fault whose context is looked up.
+//   - show: Whether sensitive values are returned unredacted.
+//
+// Returns:
+//   - func(string) (any, bool): The lookup function. Nil if the fault is nil.
+func lookupOf(fault flt.Fault, show bool) func(string) (any, bool) {
+	if fault == nil {
+		return nil
+	}
+
+	base, ok := Access[*flt.BaseFault](fault)
+	if !ok {
+		panic(flt.BadConstruction.Init())
+	}
+
+	return func(key string) (any, bool) {
+		return base.DisplayValue(key, show)
+	}
+}
+
 // GetValue gets the value of a key from the fault's context.
 //
 // Parameters:
@@ -125,11 +220,7 @@ func GetValue(fault flt.Fault, key string) (any, flt.Fault) {
 		panic(flt.BadConstruction.Init())
 	}
 
-	if len(base.context) == 0 {
-		return nil, NewNoSuchKey(key)
-	}
-
-	value, ok := base.context[key]
+	value, ok := base.Value(key)
 	if !ok {
 		return nil, NewNoSuchKey(key)
 	}
@@ -158,7 +249,7 @@ func ValueOf[T any](fault flt.Fault, key string) (T, flt.Fault) {
 	if !ok {
 		err := NewNoSuchKey(key)
 
-		SetSuggestions(err,
+		_ = SetSuggestions(err,
 			fmt.Sprintf("key with type %T does not exist, but one of type %T was found", zero, val),
 			"You may have forgotten to cast the value to the correct type or the desired key does not exist",
 		)
@@ -188,16 +279,12 @@ func SetValue(fault flt.Fault, key string, value any) flt.Fault {
 		panic(flt.BadConstruction.Init())
 	}
 
-	if len(base.context) == 0 {
-		return NewNoSuchKey(key)
-	}
-
-	_, ok = base.context[key]
+	_, ok = base.Value(key)
 	if !ok {
 		return NewNoSuchKey(key)
 	}
 
-	base.context[key] = value
+	base.SetKey(key, value)
 
 	return nil
 }
@@ -250,7 +337,7 @@ func DeleteKey(fault flt.Fault, key string) {
 		panic(flt.BadConstruction.Init())
 	}
 
-	delete(base.context, key)
+	base.RemoveKey(key)
 }
 
 // SetSuggestions sets the fault's suggestions; ignoring any empty suggestions.
@@ -292,7 +379,7 @@ func SetSuggestions(fault flt.Fault, suggestions ...string) bool {
 		}
 	}
 
-	base.suggestions = append(base.suggestions, filtered...)
+	base.AddSuggestions(filtered...)
 
 	return true
 }
@@ -0,0 +1,74 @@
+package faults_test
+
+import (
+	"errors"
+	"testing"
+
+	flt "github.com/PlayerR9/go-fault"
+	"github.com/PlayerR9/go-fault/faults"
+)
+
+func TestMessageOf(t *testing.T) {
+	plain := flt.NewDescriptor(flt.ERROR, flt.BadParameter, "expected {key} or {{ %d }}").Init()
+	_ = faults.AddKey(plain, "key", "value")
+
+	templated := flt.NewTemplateDescriptor(flt.ERROR, flt.BadParameter, "expected {key:q} or {{ %d }}").Init()
+	_ = faults.AddKey(templated, "key", "value")
+
+	secret := flt.NewTemplateDescriptor(flt.ERROR, flt.BadParameter, "bad token {token}").Init()
+	_ = faults.AddKey(secret, "token", "s3cr3t", faults.Sensitive())
+
+	tests := []struct {
+		name  string
+		fault flt.Fault
+		want  string
+	}{
+		{name: "nil", fault: nil, want: ""},
+		{name: "plain message", fault: plain, want: "expected {key} or {{ %d }}"},
+		{name: "template", fault: templated, want: `expected "value" or { %d }`},
+		{name: "redacted placeholder", fault: secret, want: "bad token " + flt.Redacted},
+		{name: "plain message of New", fault: flt.New(flt.BadParameter, "map[{a}:1]"), want: "map[{a}:1]"},
+		{name: "wrap", fault: faults.WrapErr(errors.New("x"), flt.OperationFailed, "{x}}"), want: "{x}}"},
+		{name: "wrapf", fault: faults.Wrapf(faults.FromErr(errors.New("x")), flt.OperationFailed, "got %v", map[string]int{"{a}": 1}), want: "got map[{a}:1]"},
+		{name: "bad parameter", fault: faults.NewBadParameter("{x} must be positive"), want: "{x} must be positive"},
+		{name: "nil parameter", fault: faults.NewNilParameter("cfg"), want: `parameter ("cfg") must be non-nil`},
+		{name: "no such key", fault: faults.NewNoSuchKey("id"), want: `the specified key ("id") does not exist`},
+		{
+			name:  "invalid field",
+			fault: faults.NewInvalidField(faults.FieldPath{}.Field("user").Index(2), "must match {a-z}"),
+			want:  "user[2]: must match {a-z}",
+		},
+		{
+			name:  "query syntax",
+			fault: faults.NewErrQuerySyntax("code=", 6, "expected {value}"),
+			want:  "invalid query at column 6: expected {value}",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := faults.MessageOf(tt.fault); got != tt.want {
+				t.Errorf("MessageOf: got %q, want %q", got, tt.want)
+			}
+
+			if tt.fault == nil {
+				return
+			}
+
+			if got := faults.MessageOf(roundTrip(t, tt.fault)); got != tt.want {
+				t.Errorf("MessageOf after a JSON round trip: got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMessageOfLocalized(t *testing.T) {
+	l := faults.NewLocalizer(nil, "fr")
+
+	got := l.ErrorOf(faults.NewNilParameter("cfg"))
+
+	want := `[ERROR] (BadParameter) le paramètre ("cfg") doit être non nul`
+	if got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
@@ -1,23 +1,88 @@
 package faults
 
-import flt "github.com/PlayerR9/go-fault"
+import (
+	"context"
 
+	flt "github.com/PlayerR9/go-fault"
+)
+
+// FaultOption is an option that applies to a newly created fault.
 type FaultOption func(fault flt.Fault)
 
-func WithAt(at string) FaultOption {
-	return func(fault flt.Fault) {
-		_ = AddKey(fault, "at", at)
+// apply applies the options to a newly created fault and then emits its
+// flt.CreateEvent; so that the hooks see the fault as it is returned, with its keys and
+// options. (See flt.EmitCreate.)
+//
+// Parameters:
+//   - fault: The new fault, created with flt.NewBase.
+//   - opts: The options to apply.
+//
+// Returns:
+//   - F: The fault.
+func apply[F flt.Fault](fault F, opts []FaultOption) F {
+	for _, opt := range opts {
+		opt(fault)
 	}
+
+	flt.EmitCreate(context.Background(), fault)
+
+	return fault
 }
 
-func WithBefore(before string) FaultOption {
+var (
+	// AtKey is the key of the position at which the fault occurred. (See WithAt.)
+	AtKey Key[string] = NewKey[string]("at")
+
+	// BeforeKey is the key of what comes before the position of the fault. (See WithBefore.)
+	BeforeKey Key[string] = NewKey[string]("before")
+
+	// AfterKey is the key of what comes after the position of the fault. (See WithAfter.)
+	AfterKey Key[string] = NewKey[string]("after")
+)
+
+// With returns an option that sets a typed key of the fault's context.
+//
+// Parameters:
+//   - key: The key to set.
+//   - value: The value to set.
+//
+// Returns:
+//   - FaultOption: The option. Never returns nil.
+func With[T any](key Key[T], value T) FaultOption {
 	return func(fault flt.Fault) {
-		_ = AddKey(fault, "before", before)
+		_ = Set(fault, key, value)
 	}
 }
 
+// WithAt returns an option that sets AtKey.
+//
+// Parameters:
+//   - at: The position at which the fault occurred.
+//
+// Returns:
+//   - FaultOption: The option. Never returns nil.
+func WithAt(at string) FaultOption {
+	return With(AtKey, at)
+}
+
+// WithBefore returns an option that sets BeforeKey.
+//
+// Parameters:
+//   - before: What comes before the position of the fault.
+//
+// Returns:
+//   - FaultOption: The option. Never returns nil.
+func WithBefore(before string) FaultOption {
+	return With(BeforeKey, before)
+}
+
+// WithAfter returns an option that sets AfterKey.
+//
+// Parameters:
+//   - after: What comes after the position of the fault.
+//
+// Returns:
+//   - FaultOption: The option. Never returns nil.
 func WithAfter(after string) FaultOption {
-	return func(fault flt.Fault) {
-		_ = AddKey(fault, "after", after)
-	}
+	return With(AfterKey, after)
 }
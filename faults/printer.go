@@ -13,7 +13,8 @@ import (
 //   - []string: The fault's additional information.
 //
 // An empty line is added between the message and the embedding tower. Also, a "dot" is
-// added at the end of the message.
+// added at the end of the message. If the fault has a cause (see Wrap), the lines of the
+// cause follow in an indented "Caused by:" section; and so on for the cause's cause.
 func LinesOf(fault flt.Fault) []string {
 	if fault == nil {
 		return nil
@@ -27,5 +28,79 @@ func LinesOf(fault flt.Fault) []string {
 	tmp := flt.InfoLines(fault)
 	lines = append(lines, tmp...)
 
+	lines = appendCause(lines, fault, "Caused by:", LinesOf)
+
+	return lines
+}
+
+// UnredactedLinesOf is like LinesOf but the sensitive values are rendered as-is. It
+// should never be used for logs.
+//
+// Parameters:
+//   - fault: The fault whose additional information are to be written.
+//
+// Returns:
+//   - []string: The fault's additional information.
+func UnredactedLinesOf(fault flt.Fault) []string {
+	if fault == nil {
+		return nil
+	}
+
+	desc := DescriptorOf(fault)
+	msg := expandOf(fault, desc, desc.Message(), true)
+
+	var lines []string
+
+	lines = append(lines, flt.FormatHeader(desc.Level(), desc.Code(), msg)+".")
+	lines = append(lines, "")
+
+	tmp := flt.InfoLinesIn(fault, flt.Unredacted(nil))
+	lines = append(lines, tmp...)
+
+	lines = appendCause(lines, fault, "Caused by:", UnredactedLinesOf)
+
+	return lines
+}
+
+// appendCause appends the "Caused by:" section of the fault's cause, if any, to the lines.
+//
+// Format:
+//
+//	""
+//	"<caused_by>"
+//	"  <line>"
+//	"  ..."
+//
+// Where, each <line> is a line of the cause, as given by lines_of; empty lines are kept
+// empty.
+//
+// Parameters:
+//   - lines: The lines to append to.
+//   - fault: The fault whose cause is to be rendered.
+//   - caused_by: The title of the section.
+//   - lines_of: The function that renders the cause.
+//
+// Returns:
+//   - []string: The lines with the section appended.
+func appendCause(lines []string, fault flt.Fault, caused_by string, lines_of func(fault flt.Fault) []string) []string {
+	cause := flt.Unwrap(fault)
+	if cause == nil {
+		return lines
+	}
+
+	if len(lines) > 0 && lines[len(lines)-1] != "" {
+		lines = append(lines, "")
+	}
+
+	lines = append(lines, caused_by)
+
+	for _, line := range lines_of(cause) {
+		if line == "" {
+			lines = append(lines, "")
+		} else {
+			lines = append(lines, "  "+line)
+		}
+	}
+
 	return lines
 }
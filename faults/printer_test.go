@@ -0,0 +1,165 @@
+package faults_test
+
+import (
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	flt "github.com/PlayerR9/go-fault"
+	"github.com/PlayerR9/go-fault/faults"
+	"github.com/PlayerR9/go-fault/faults/faulttest"
+)
+
+func TestLinesOfCauses(t *testing.T) {
+	faulttest.UseClock(t, faulttest.NewFakeClock(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)))
+
+	secret := faults.NewBadParameter("x must be positive")
+	_ = faults.AddKey(secret, "token", "s3cr3t", faults.Sensitive())
+
+	chain := faults.Wrap(faults.Wrap(secret, flt.OperationFailed, "could not parse"), flt.OperationFailed, "could not load")
+
+	joined := faults.Join(
+		faults.WrapErr(errors.New("disk full"), flt.OperationFailed, "could not save"),
+		faults.Wrap(secret, flt.OperationFailed, "could not parse"),
+	)
+
+	tests := []struct {
+		name       string
+		fault      flt.Fault
+		want       []string
+		unredacted []string
+	}{
+		{
+			name:  "cause of a cause",
+			fault: chain,
+			want: []string{
+				"[ERROR] (OperationFailed) could not load.",
+				"",
+				"Occurred at: 2024-01-01 00:00:00 +0000 UTC",
+				"",
+				"Caused by:",
+				"  [ERROR] (OperationFailed) could not parse.",
+				"",
+				"  Occurred at: 2024-01-01 00:00:00 +0000 UTC",
+				"",
+				"  Caused by:",
+				"    [ERROR] (BadParameter) x must be positive.",
+				"",
+				"    Occurred at: 2024-01-01 00:00:00 +0000 UTC",
+				"    Context:",
+				"    - token: " + flt.Redacted,
+			},
+			unredacted: []string{
+				"[ERROR] (OperationFailed) could not load.",
+				"",
+				"Occurred at: 2024-01-01 00:00:00 +0000 UTC",
+				"",
+				"Caused by:",
+				"  [ERROR] (OperationFailed) could not parse.",
+				"",
+				"  Occurred at: 2024-01-01 00:00:00 +0000 UTC",
+				"",
+				"  Caused by:",
+				"    [ERROR] (BadParameter) x must be positive.",
+				"",
+				"    Occurred at: 2024-01-01 00:00:00 +0000 UTC",
+				"    Context:",
+				"    - token: s3cr3t",
+			},
+		},
+		{
+			name:  "causes in a join",
+			fault: joined,
+			want: []string{
+				"[ERROR] (FaultJoin) joined 2 faults.",
+				"",
+				"Occurred at: 2024-01-01 00:00:00 +0000 UTC",
+				"- [ERROR] (OperationFailed) could not save",
+				"  Occurred at: 2024-01-01 00:00:00 +0000 UTC",
+				"",
+				"  Caused by:",
+				"    [ERROR] (UnknownCode) something went wrong.",
+				"",
+				"    Occurred at: 2024-01-01 00:00:00 +0000 UTC",
+				"    - Error: disk full",
+				"- [ERROR] (OperationFailed) could not parse",
+				"  Occurred at: 2024-01-01 00:00:00 +0000 UTC",
+				"",
+				"  Caused by:",
+				"    [ERROR] (BadParameter) x must be positive.",
+				"",
+				"    Occurred at: 2024-01-01 00:00:00 +0000 UTC",
+				"    Context:",
+				"    - token: " + flt.Redacted,
+			},
+			unredacted: []string{
+				"[ERROR] (FaultJoin) joined 2 faults.",
+				"",
+				"Occurred at: 2024-01-01 00:00:00 +0000 UTC",
+				"- [ERROR] (OperationFailed) could not save",
+				"  Occurred at: 2024-01-01 00:00:00 +0000 UTC",
+				"",
+				"  Caused by:",
+				"    [ERROR] (UnknownCode) something went wrong.",
+				"",
+				"    Occurred at: 2024-01-01 00:00:00 +0000 UTC",
+				"    - Error: disk full",
+				"- [ERROR] (OperationFailed) could not parse",
+				"  Occurred at: 2024-01-01 00:00:00 +0000 UTC",
+				"",
+				"  Caused by:",
+				"    [ERROR] (BadParameter) x must be positive.",
+				"",
+				"    Occurred at: 2024-01-01 00:00:00 +0000 UTC",
+				"    Context:",
+				"    - token: s3cr3t",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := faults.LinesOf(tt.fault)
+
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("LinesOf: got:\n%s\nwant:\n%s", strings.Join(got, "\n"), strings.Join(tt.want, "\n"))
+			}
+
+			got = faults.UnredactedLinesOf(tt.fault)
+
+			if !slices.Equal(got, tt.unredacted) {
+				t.Errorf("UnredactedLinesOf: got:\n%s\nwant:\n%s", strings.Join(got, "\n"), strings.Join(tt.unredacted, "\n"))
+			}
+		})
+	}
+}
+
+func TestLinesOfCausesLocalized(t *testing.T) {
+	faulttest.UseClock(t, faulttest.NewFakeClock(time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)))
+
+	fault := faults.Join(faults.Wrap(faults.NewNilParameter("cfg"), flt.OperationFailed, "could not load"))
+
+	got := faults.NewLocalizer(nil, "fr").LinesOf(fault)
+
+	want := []string{
+		`  Causé par :`,
+		`    [ERROR] (BadParameter) le paramètre ("cfg") doit être non nul.`,
+	}
+
+	if !containsRun(got, want) {
+		t.Errorf("got:\n%s\nwant the lines:\n%s", strings.Join(got, "\n"), strings.Join(want, "\n"))
+	}
+}
+
+// containsRun tells whether the lines contain the run of lines.
+func containsRun(lines, run []string) bool {
+	for i := range lines {
+		if i+len(run) <= len(lines) && slices.Equal(lines[i:i+len(run)], run) {
+			return true
+		}
+	}
+
+	return false
+}
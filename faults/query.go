@@ -0,0 +1,882 @@
+package faults
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	flt "github.com/PlayerR9/go-fault"
+)
+
+var (
+	// ColumnKey is the key of the column of ErrQuerySyntax faults.
+	ColumnKey Key[int] = NewKey[int]("column")
+)
+
+// ErrQuerySyntax is a BadParameter fault about a query that cannot be parsed. (See
+// ParseQuery.) Its message is prefixed with the column of the error.
+type ErrQuerySyntax struct {
+	flt.Fault
+
+	// Query is the query that cannot be parsed.
+	Query string
+
+	// Column is the column of the error; in runes, starting from 1.
+	Column int
+}
+
+// Embeds implements the flt.Fault interface.
+func (e ErrQuerySyntax) Embeds() flt.Fault {
+	return e.Fault
+}
+
+// InfoLines implements the flt.Fault interface.
+//
+// Format:
+//
+//	"- Query: <query>"
+//	"         ^"
+//
+// Where, the caret is under the column of the error.
+func (e ErrQuerySyntax) InfoLines() []string {
+	return e.InfoLinesIn(nil)
+}
+
+// InfoLinesIn implements the flt.LocalizedInfoer interface.
+func (e ErrQuerySyntax) InfoLinesIn(tr flt.Translator) []string {
+	label := "- " + translate(tr, "Query:") + " "
+
+	indent := utf8.RuneCountInString(label) + max(e.Column, 1) - 1
+
+	return []string{
+		label + e.Query,
+		strings.Repeat(" ", indent) + "^",
+	}
+}
+
+// NewErrQuerySyntax creates a new ErrQuerySyntax. It is built on NewBadParameter.
+//
+// Parameters:
+//   - query: The query that cannot be parsed.
+//   - column: The column of the error; in runes, starting from 1.
+//   - msg: The message of the fault. (e.g., "unknown field \"lvl\"")
+//
+// Returns:
+//   - *ErrQuerySyntax: The new ErrQuerySyntax. Never returns nil.
+func NewErrQuerySyntax(query string, column int, msg string, opts ...FaultOption) *ErrQuerySyntax {
+	fault := &ErrQuerySyntax{
+		Fault:  newBadParameterTemplate("invalid query at column {column}: " + escapeTemplate(msg)),
+		Query:  query,
+		Column: column,
+	}
+
+	_ = Set(fault, ColumnKey, column)
+
+	return apply(fault, opts)
+}
+
+// Query is a compiled fault query. (See ParseQuery.)
+type Query struct {
+	// text is the text of the query.
+	text string
+
+	// expr is the compiled query.
+	expr queryExpr
+}
+
+// queryExpr is a compiled query, or a part of one.
+type queryExpr struct {
+	// node checks a single fault; the negations included.
+	node Matcher
+
+	// tree checks a whole fault tree. Nil if the expression has no negation; in which case
+	// it holds when a fault of the tree satisfies node.
+	tree func(fault flt.Fault) bool
+}
+
+// matches checks whether the expression selects the fault tree.
+//
+// Parameters:
+//   - fault: The root of the tree.
+//
+// Returns:
+//   - bool: True if the expression selects the tree, false otherwise.
+func (e queryExpr) matches(fault flt.Fault) bool {
+	if e.tree == nil {
+		return Match(fault, e.node)
+	}
+
+	return e.tree(fault)
+}
+
+// and returns the conjunction of the expressions. Without negations, both must be
+// satisfied by the same fault.
+//
+// Parameters:
+//   - other: The other expression.
+//
+// Returns:
+//   - queryExpr: The conjunction.
+func (e queryExpr) and(other queryExpr) queryExpr {
+	result := queryExpr{
+		node: e.node.And(other.node),
+	}
+
+	if e.tree != nil || other.tree != nil {
+		result.tree = func(fault flt.Fault) bool {
+			return e.matches(fault) && other.matches(fault)
+		}
+	}
+
+	return result
+}
+
+// or returns the disjunction of the expressions.
+//
+// Parameters:
+//   - other: The other expression.
+//
+// Returns:
+//   - queryExpr: The disjunction.
+func (e queryExpr) or(other queryExpr) queryExpr {
+	result := queryExpr{
+		node: e.node.Or(other.node),
+	}
+
+	if e.tree != nil || other.tree != nil {
+		result.tree = func(fault flt.Fault) bool {
+			return e.matches(fault) || other.matches(fault)
+		}
+	}
+
+	return result
+}
+
+// not returns the negation of the expression; which holds when no fault of the tree
+// satisfies it.
+//
+// Returns:
+//   - queryExpr: The negation.
+func (e queryExpr) not() queryExpr {
+	return queryExpr{
+		node: Not(e.node),
+		tree: func(fault flt.Fault) bool {
+			return !e.matches(fault)
+		},
+	}
+}
+
+// ParseQuery parses a fault query; such as:
+//
+//	level>=ERROR and code=std.BadParameter and ctx.tenant="acme" and msg~"timeout"
+//
+// A query is made of conditions combined with "and", "or", "not" and parentheses; "and"
+// binding tighter than "or". A condition is "<field> <operator> <value>", where <value> is
+// either a word or a double-quoted Go string. The fields are:
+//   - level: The level of the fault, compared with =, !=, <, <=, > and >=. Levels are
+//     ordered by severity, as by flt.FaultLevel.Compare; the more severe, the greater.
+//     As such, level>=ERROR selects the ERROR and FATAL faults, like LevelAtLeast, and
+//     level<=ERROR the ERROR faults and the less severe ones, like LevelAtMost.
+//   - code: The code of the fault, compared with = and !=. The value is the name of the
+//     code, optionally qualified with its type (e.g., fault.StandardCode.BadParameter),
+//     the package of its type (e.g., fault.BadParameter) or "std" for the standard codes
+//     (e.g., std.BadParameter).
+//   - msg: The message of the fault, as given by MessageOf, compared with = and != or
+//     matched against a regular expression with ~ and !~.
+//   - type: The Go type of the fault (e.g., *faults.ErrNoSuchKey), compared as msg.
+//   - ctx.<key>: The value of the key of the fault's context, compared as msg or, with <,
+//     <=, > and >=, as numbers if both are numbers and as strings otherwise. Alone, it
+//     checks that the key exists.
+//
+// Negations are checked against the whole tree: "not <expr>", as well as the conditions
+// with the != and !~ operators, hold when no fault of the tree satisfies the expression or
+// condition they negate. As such, "not code=BadParameter", like "code!=BadParameter", does
+// not select a join of BadParameter faults even though the join itself has another code.
+// The other conditions, when combined with "and" and "or" and no negation, are checked
+// against the same fault; which can be any fault of the tree: a layer of an embedding
+// tower, a cause or a joined fault. (See Match.)
+//
+// The values of the context are compared in their redacted form; so that a query cannot
+// reveal sensitive values. (See flt.BaseFault.RedactedValue.)
+//
+// Parameters:
+//   - text: The text of the query.
+//
+// Returns:
+//   - *Query: The compiled query.
+//   - flt.Fault: An *ErrQuerySyntax if the query cannot be parsed, nil otherwise.
+func ParseQuery(text string) (*Query, flt.Fault) {
+	p, err := newQueryParser(text)
+	if err != nil {
+		return nil, err
+	}
+
+	if p.peek().kind == tokEOF {
+		return nil, p.errorAt(p.peek(), "the query is empty")
+	}
+
+	expr, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+
+	tk := p.peek()
+	if tk.kind != tokEOF {
+		return nil, p.errorAt(tk, fmt.Sprintf("unexpected %s", tk))
+	}
+
+	q := &Query{
+		text: text,
+		expr: expr,
+	}
+
+	return q, nil
+}
+
+// MustParseQuery is like ParseQuery but panics with the fault if the query cannot be
+// parsed. It is meant for queries known at compile time.
+//
+// Parameters:
+//   - text: The text of the query.
+//
+// Returns:
+//   - *Query: The compiled query. Never returns nil.
+func MustParseQuery(text string) *Query {
+	q, err := ParseQuery(text)
+	if err != nil {
+		panic(err)
+	}
+
+	return q
+}
+
+// String implements the fmt.Stringer interface.
+//
+// Returns the text of the query.
+func (q Query) String() string {
+	return q.text
+}
+
+// Matcher returns the matcher of a single fault that the query is compiled into. Unlike
+// Matches, its negations only apply to the fault it checks.
+//
+// Returns:
+//   - Matcher: The matcher. Never returns nil.
+func (q Query) Matcher() Matcher {
+	return q.expr.node
+}
+
+// Matches checks whether the query selects the fault; that is, whether it or any fault it
+// leads to satisfies the query while none of them satisfies its negations. (See
+// ParseQuery.)
+//
+// Parameters:
+//   - fault: The fault to check.
+//
+// Returns:
+//   - bool: True if the query selects the fault, false otherwise. False if the fault is
+//     nil.
+func (q Query) Matches(fault flt.Fault) bool {
+	if fault == nil {
+		return false
+	}
+
+	return q.expr.matches(fault)
+}
+
+// tokenKind is the kind of a token of a query.
+type tokenKind int
+
+const (
+	// tokEOF is the end of the query.
+	tokEOF tokenKind = iota
+
+	// tokWord is a word; such as a field, a keyword or an unquoted value.
+	tokWord
+
+	// tokString is a double-quoted string.
+	tokString
+
+	// tokOperator is a comparison operator.
+	tokOperator
+
+	// tokLParen is an opening parenthesis.
+	tokLParen
+
+	// tokRParen is a closing parenthesis.
+	tokRParen
+)
+
+// queryToken is a token of a query.
+type queryToken struct {
+	// kind is the kind of the token.
+	kind tokenKind
+
+	// text is the text of the token. For strings, it is unquoted.
+	text string
+
+	// column is the column of the token; in runes, starting from 1.
+	column int
+}
+
+// String implements the fmt.Stringer interface.
+func (tk queryToken) String() string {
+	switch tk.kind {
+	case tokEOF:
+		return "end of query"
+	case tokString:
+		return strconv.Quote(tk.text)
+	default:
+		return "\"" + tk.text + "\""
+	}
+}
+
+// is checks whether the token is the given keyword; regardless of its case.
+//
+// Parameters:
+//   - keyword: The keyword.
+//
+// Returns:
+//   - bool: True if the token is the keyword, false otherwise.
+func (tk queryToken) is(keyword string) bool {
+	return tk.kind == tokWord && strings.EqualFold(tk.text, keyword)
+}
+
+// queryParser is the parser of a query.
+type queryParser struct {
+	// text is the text of the query.
+	text string
+
+	// tokens are the tokens of the query; ending with a tokEOF token.
+	tokens []queryToken
+
+	// pos is the position of the next token.
+	pos int
+}
+
+// isWordRune checks whether the rune can be part of a word.
+//
+// Parameters:
+//   - r: The rune.
+//
+// Returns:
+//   - bool: True if the rune can be part of a word, false otherwise.
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r) || strings.ContainsRune("_.*/[]-+", r)
+}
+
+// newQueryParser tokenizes a query.
+//
+// Parameters:
+//   - text: The text of the query.
+//
+// Returns:
+//   - *queryParser: The parser.
+//   - flt.Fault: An *ErrQuerySyntax if the query cannot be tokenized, nil otherwise.
+func newQueryParser(text string) (*queryParser, flt.Fault) {
+	p := &queryParser{
+		text: text,
+	}
+
+	runes := []rune(text)
+
+	for i := 0; i < len(runes); {
+		r := runes[i]
+		column := i + 1
+
+		switch {
+		case unicode.IsSpace(r):
+			i++
+		case r == '(':
+			p.tokens = append(p.tokens, queryToken{kind: tokLParen, text: "(", column: column})
+			i++
+		case r == ')':
+			p.tokens = append(p.tokens, queryToken{kind: tokRParen, text: ")", column: column})
+			i++
+		case r == '"':
+			j := i + 1
+
+			for j < len(runes) && runes[j] != '"' {
+				if runes[j] == '\\' {
+					j++
+				}
+
+				j++
+			}
+
+			if j >= len(runes) {
+				return nil, NewErrQuerySyntax(text, column, "unterminated string")
+			}
+
+			str, err := strconv.Unquote(string(runes[i : j+1]))
+			if err != nil {
+				return nil, NewErrQuerySyntax(text, column, "invalid string")
+			}
+
+			p.tokens = append(p.tokens, queryToken{kind: tokString, text: str, column: column})
+			i = j + 1
+		case strings.ContainsRune("=!<>~", r):
+			op := string(r)
+
+			if i+1 < len(runes) && strings.ContainsRune("=~", runes[i+1]) && r != '=' && r != '~' {
+				op += string(runes[i+1])
+			}
+
+			if op == "!" || op == "<~" || op == ">~" {
+				return nil, NewErrQuerySyntax(text, column, fmt.Sprintf("unknown operator %q", op))
+			}
+
+			p.tokens = append(p.tokens, queryToken{kind: tokOperator, text: op, column: column})
+			i += utf8.RuneCountInString(op)
+		case isWordRune(r):
+			j := i
+
+			for j < len(runes) && isWordRune(runes[j]) {
+				j++
+			}
+
+			p.tokens = append(p.tokens, queryToken{kind: tokWord, text: string(runes[i:j]), column: column})
+			i = j
+		default:
+			return nil, NewErrQuerySyntax(text, column, fmt.Sprintf("unexpected character %q", r))
+		}
+	}
+
+	p.tokens = append(p.tokens, queryToken{kind: tokEOF, column: len(runes) + 1})
+
+	return p, nil
+}
+
+// peek returns the next token without consuming it.
+//
+// Returns:
+//   - queryToken: The next token.
+func (p *queryParser) peek() queryToken {
+	return p.tokens[p.pos]
+}
+
+// next consumes and returns the next token. The tokEOF token is never consumed.
+//
+// Returns:
+//   - queryToken: The next token.
+func (p *queryParser) next() queryToken {
+	tk := p.tokens[p.pos]
+
+	if tk.kind != tokEOF {
+		p.pos++
+	}
+
+	return tk
+}
+
+// errorAt returns the fault of a syntax error at the token.
+//
+// Parameters:
+//   - tk: The token at which the error is.
+//   - msg: The message of the fault.
+//
+// Returns:
+//   - flt.Fault: The fault. Never returns nil.
+func (p *queryParser) errorAt(tk queryToken, msg string) flt.Fault {
+	return NewErrQuerySyntax(p.text, tk.column, msg)
+}
+
+// parseOr parses the rule:
+//
+//	or = and { "or" and }
+//
+// Returns:
+//   - queryExpr: The compiled rule.
+//   - flt.Fault: The fault that occurred while parsing, if any.
+func (p *queryParser) parseOr() (queryExpr, flt.Fault) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return queryExpr{}, err
+	}
+
+	for p.peek().is("or") {
+		p.next()
+
+		right, err := p.parseAnd()
+		if err != nil {
+			return queryExpr{}, err
+		}
+
+		left = left.or(right)
+	}
+
+	return left, nil
+}
+
+// parseAnd parses the rule:
+//
+//	and = unary { "and" unary }
+//
+// Returns:
+//   - queryExpr: The compiled rule.
+//   - flt.Fault: The fault that occurred while parsing, if any.
+func (p *queryParser) parseAnd() (queryExpr, flt.Fault) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return queryExpr{}, err
+	}
+
+	for p.peek().is("and") {
+		p.next()
+
+		right, err := p.parseUnary()
+		if err != nil {
+			return queryExpr{}, err
+		}
+
+		left = left.and(right)
+	}
+
+	return left, nil
+}
+
+// parseUnary parses the rule:
+//
+//	unary = "not" unary | "(" or ")" | condition
+//
+// Returns:
+//   - queryExpr: The compiled rule.
+//   - flt.Fault: The fault that occurred while parsing, if any.
+func (p *queryParser) parseUnary() (queryExpr, flt.Fault) {
+	tk := p.peek()
+
+	switch {
+	case tk.is("not"):
+		p.next()
+
+		inner, err := p.parseUnary()
+		if err != nil {
+			return queryExpr{}, err
+		}
+
+		return inner.not(), nil
+	case tk.kind == tokLParen:
+		p.next()
+
+		inner, err := p.parseOr()
+		if err != nil {
+			return queryExpr{}, err
+		}
+
+		closing := p.next()
+		if closing.kind != tokRParen {
+			return queryExpr{}, p.errorAt(closing, fmt.Sprintf("expected \")\", got %s", closing))
+		}
+
+		return inner, nil
+	default:
+		return p.parseCondition()
+	}
+}
+
+// parseCondition parses the rule:
+//
+//	condition = field [ operator value ]
+//
+// Returns:
+//   - queryExpr: The compiled rule.
+//   - flt.Fault: The fault that occurred while parsing, if any.
+func (p *queryParser) parseCondition() (queryExpr, flt.Fault) {
+	field := p.next()
+
+	if field.kind != tokWord || field.is("and") || field.is("or") || field.is("not") {
+		return queryExpr{}, p.errorAt(field, fmt.Sprintf("expected a field, got %s", field))
+	}
+
+	name := strings.ToLower(field.text)
+
+	key, is_ctx := strings.CutPrefix(field.text, "ctx.")
+	if is_ctx && key == "" {
+		return queryExpr{}, p.errorAt(field, "expected the name of a key after \"ctx.\"")
+	}
+
+	if !is_ctx && name != "level" && name != "code" && name != "msg" && name != "type" {
+		return queryExpr{}, p.errorAt(field, fmt.Sprintf("unknown field %q", field.text))
+	}
+
+	op := p.peek()
+	if op.kind != tokOperator {
+		if is_ctx {
+			return queryExpr{node: hasKey(key, false)}, nil
+		}
+
+		return queryExpr{}, p.errorAt(op, fmt.Sprintf("expected an operator after %q, got %s", field.text, op))
+	}
+
+	p.next()
+
+	value := p.next()
+	if value.kind != tokWord && value.kind != tokString {
+		return queryExpr{}, p.errorAt(value, fmt.Sprintf("expected a value after %q, got %s", op.text, value))
+	}
+
+	switch {
+	case is_ctx:
+		return p.keyCondition(key, op, value)
+	case name == "level":
+		return p.levelCondition(op, value)
+	case name == "code":
+		return p.codeCondition(op, value)
+	case name == "msg":
+		return p.textCondition(MessageOf, op, value)
+	default:
+		return p.textCondition(func(fault flt.Fault) string {
+			return fmt.Sprintf("%T", fault)
+		}, op, value)
+	}
+}
+
+// levelCondition compiles a condition on the level.
+//
+// Parameters:
+//   - op: The operator.
+//   - value: The value.
+//
+// Returns:
+//   - queryExpr: The compiled condition.
+//   - flt.Fault: The fault that occurred while compiling, if any.
+func (p *queryParser) levelCondition(op, value queryToken) (queryExpr, flt.Fault) {
+	level, err := flt.ParseLevel(value.text)
+	if err != nil {
+		return queryExpr{}, p.errorAt(value, fmt.Sprintf("unknown level %q", value.text))
+	}
+
+	var m Matcher
+
+	switch op.text {
+	case "=", "!=":
+		m = func(fault flt.Fault) bool {
+			return LevelOf(fault).Compare(level) == 0
+		}
+	case "<":
+		m = Not(LevelAtLeast(level))
+	case "<=":
+		m = LevelAtMost(level)
+	case ">":
+		m = Not(LevelAtMost(level))
+	case ">=":
+		m = LevelAtLeast(level)
+	default:
+		return queryExpr{}, p.errorAt(op, fmt.Sprintf("operator %q cannot be used with levels", op.text))
+	}
+
+	expr := queryExpr{node: m}
+
+	if op.text == "!=" {
+		expr = expr.not()
+	}
+
+	return expr, nil
+}
+
+// codeCondition compiles a condition on the code.
+//
+// Parameters:
+//   - op: The operator.
+//   - value: The value.
+//
+// Returns:
+//   - queryExpr: The compiled condition.
+//   - flt.Fault: The fault that occurred while compiling, if any.
+func (p *queryParser) codeCondition(op, value queryToken) (queryExpr, flt.Fault) {
+	if op.text != "=" && op.text != "!=" {
+		return queryExpr{}, p.errorAt(op, fmt.Sprintf("operator %q cannot be used with codes", op.text))
+	}
+
+	qualifier, name := "", value.text
+
+	idx := strings.LastIndexByte(value.text, '.')
+	if idx >= 0 {
+		qualifier, name = value.text[:idx], value.text[idx+1:]
+	}
+
+	if name == "" {
+		return queryExpr{}, p.errorAt(value, fmt.Sprintf("invalid code %q", value.text))
+	}
+
+	std_type := fmt.Sprintf("%T", flt.StandardCode(0))
+
+	m := func(fault flt.Fault) bool {
+		code := DescriptorOf(fault).Code()
+		if code.String() != name {
+			return false
+		}
+
+		if qualifier == "" {
+			return true
+		}
+
+		type_name, _ := codeTypeOf(code)
+
+		if qualifier == "std" {
+			return type_name == std_type
+		}
+
+		pkg, _, _ := strings.Cut(strings.TrimLeft(type_name, "*"), ".")
+
+		return type_name == qualifier || pkg == qualifier
+	}
+
+	expr := queryExpr{node: m}
+
+	if op.text == "!=" {
+		expr = expr.not()
+	}
+
+	return expr, nil
+}
+
+// textCondition compiles a condition on a text of the fault; such as its message.
+//
+// Parameters:
+//   - text_of: The function that returns the text of a fault.
+//   - op: The operator.
+//   - value: The value.
+//
+// Returns:
+//   - queryExpr: The compiled condition.
+//   - flt.Fault: The fault that occurred while compiling, if any.
+func (p *queryParser) textCondition(text_of func(fault flt.Fault) string, op, value queryToken) (queryExpr, flt.Fault) {
+	var pred func(text string) bool
+
+	switch op.text {
+	case "=", "!=":
+		pred = func(text string) bool { return text == value.text }
+	case "~", "!~":
+		re, err := p.compile(value)
+		if err != nil {
+			return queryExpr{}, err
+		}
+
+		pred = re.MatchString
+	default:
+		return queryExpr{}, p.errorAt(op, fmt.Sprintf("operator %q cannot be used with texts", op.text))
+	}
+
+	expr := queryExpr{
+		node: func(fault flt.Fault) bool {
+			return pred(text_of(fault))
+		},
+	}
+
+	if op.text == "!=" || op.text == "!~" {
+		expr = expr.not()
+	}
+
+	return expr, nil
+}
+
+// keyCondition compiles a condition on a key of the fault's context. The redacted value
+// of the key is compared.
+//
+// Parameters:
+//   - key: The name of the key.
+//   - op: The operator.
+//   - value: The value.
+//
+// Returns:
+//   - queryExpr: The compiled condition.
+//   - flt.Fault: The fault that occurred while compiling, if any.
+func (p *queryParser) keyCondition(key string, op, value queryToken) (queryExpr, flt.Fault) {
+	var pred func(v any) bool
+
+	switch op.text {
+	case "=", "!=":
+		pred = func(v any) bool { return fmt.Sprint(v) == value.text }
+	case "<":
+		pred = func(v any) bool { return compareValue(v, value.text) < 0 }
+	case "<=":
+		pred = func(v any) bool { return compareValue(v, value.text) <= 0 }
+	case ">":
+		pred = func(v any) bool { return compareValue(v, value.text) > 0 }
+	case ">=":
+		pred = func(v any) bool { return compareValue(v, value.text) >= 0 }
+	case "~", "!~":
+		re, err := p.compile(value)
+		if err != nil {
+			return queryExpr{}, err
+		}
+
+		pred = func(v any) bool { return re.MatchString(fmt.Sprint(v)) }
+	}
+
+	expr := queryExpr{
+		node: keyValue(key, pred, false),
+	}
+
+	if op.text == "!=" || op.text == "!~" {
+		expr = expr.not()
+	}
+
+	return expr, nil
+}
+
+// compile compiles the regular expression of a value.
+//
+// Parameters:
+//   - value: The value.
+//
+// Returns:
+//   - *regexp.Regexp: The compiled regular expression.
+//   - flt.Fault: The fault that occurred while compiling, if any.
+func (p *queryParser) compile(value queryToken) (*regexp.Regexp, flt.Fault) {
+	re, err := regexp.Compile(value.text)
+	if err != nil {
+		return nil, p.errorAt(value, fmt.Sprintf("invalid regular expression %q", value.text))
+	}
+
+	return re, nil
+}
+
+// compareValue compares a value of a fault's context with the value of a query; as
+// numbers if both are numbers and as strings otherwise.
+//
+// Parameters:
+//   - v: The value of the context.
+//   - literal: The value of the query.
+//
+// Returns:
+//   - int: -1 if v is less than the literal, 1 if it is greater, 0 if they are equal.
+func compareValue(v any, literal string) int {
+	n, err := strconv.ParseFloat(literal, 64)
+	if err == nil {
+		rv := reflect.ValueOf(v)
+
+		var f float64
+
+		ok := true
+
+		switch rv.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			f = float64(rv.Int())
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+			f = float64(rv.Uint())
+		case reflect.Float32, reflect.Float64:
+			f = rv.Float()
+		default:
+			ok = false
+		}
+
+		if ok {
+			switch {
+			case f < n:
+				return -1
+			case f > n:
+				return 1
+			default:
+				return 0
+			}
+		}
+	}
+
+	return strings.Compare(fmt.Sprint(v), literal)
+}
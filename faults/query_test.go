@@ -0,0 +1,135 @@
+package faults_test
+
+import (
+	"errors"
+	"testing"
+
+	flt "github.com/PlayerR9/go-fault"
+	"github.com/PlayerR9/go-fault/faults"
+)
+
+func TestParseQueryErrors(t *testing.T) {
+	tests := []struct {
+		name   string
+		text   string
+		column int
+	}{
+		{name: "empty", text: "   ", column: 4},
+		{name: "unknown field", text: "size=1", column: 1},
+		{name: "missing operator", text: "code", column: 5},
+		{name: "missing value", text: "code=", column: 6},
+		{name: "unknown operator", text: "code ! x", column: 6},
+		{name: "unknown level", text: "level=LOUD", column: 7},
+		{name: "operator of codes", text: "code<BadParameter", column: 5},
+		{name: "operator of texts", text: "msg<x", column: 4},
+		{name: "invalid regular expression", text: `msg~"("`, column: 5},
+		{name: "unterminated string", text: `msg="x`, column: 5},
+		{name: "unclosed parenthesis", text: "(code=x", column: 8},
+		{name: "trailing token", text: "code=x y", column: 8},
+		{name: "missing key", text: "ctx.=1", column: 1},
+		{name: "dangling and", text: "code=x and", column: 11},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			q, err := faults.ParseQuery(tt.text)
+			if err == nil {
+				t.Fatalf("got %v, want a syntax error", q)
+			}
+
+			syntax, ok := faults.Access[*faults.ErrQuerySyntax](err)
+			if !ok {
+				t.Fatalf("got %T, want *faults.ErrQuerySyntax", err)
+			}
+
+			if syntax.Column != tt.column {
+				t.Errorf("got column %d, want %d: %s", syntax.Column, tt.column, faults.MessageOf(err))
+			}
+		})
+	}
+}
+
+func TestQueryMatches(t *testing.T) {
+	bad := faults.NewBadParameter("x must be positive")
+	_ = faults.AddKey(bad, "tenant", "acme")
+	_ = faults.AddKey(bad, "retries", 3)
+	_ = faults.AddKey(bad, "token", "s3cr3t", faults.Sensitive())
+
+	other := faults.NewBadParameter("y must be positive")
+	wrapped := faults.Wrap(bad, flt.OperationFailed, "could not save")
+	joined := faults.Join(bad, other)
+	mixed := faults.Join(bad, faults.WrapErr(errors.New("disk full"), flt.OperationFailed, "could not save"))
+
+	tests := []struct {
+		name  string
+		text  string
+		fault flt.Fault
+		want  bool
+	}{
+		{name: "code", text: "code=BadParameter", fault: bad, want: true},
+		{name: "qualified code", text: "code=std.BadParameter", fault: bad, want: true},
+		{name: "code of a cause", text: "code=BadParameter", fault: wrapped, want: true},
+		{name: "other code", text: "code=OperationFailed", fault: bad, want: false},
+		{name: "level", text: "level=ERROR", fault: bad, want: true},
+		{name: "level at least", text: "level>=ERROR", fault: bad, want: true},
+		{name: "level at most", text: "level<=ERROR", fault: bad, want: true},
+		{name: "level more severe", text: "level>ERROR", fault: bad, want: false},
+		{name: "level less severe", text: "level<ERROR", fault: bad, want: false},
+		{name: "level at least a less severe one", text: "level>=WARNING", fault: bad, want: true},
+		{name: "level at most a less severe one", text: "level<=WARNING", fault: bad, want: false},
+		{name: "level below a more severe one", text: "level<FATAL", fault: bad, want: true},
+		{name: "message", text: `msg="x must be positive"`, fault: bad, want: true},
+		{name: "message pattern", text: `msg~"^x "`, fault: joined, want: true},
+		{name: "type", text: `type="*faults.WrapFault"`, fault: wrapped, want: true},
+		{name: "key exists", text: "ctx.tenant", fault: bad, want: true},
+		{name: "key is missing", text: "ctx.region", fault: bad, want: false},
+		{name: "key value", text: "ctx.tenant=acme", fault: wrapped, want: true},
+		{name: "numeric key value", text: "ctx.retries>=3 and ctx.retries<10", fault: bad, want: true},
+
+		// The conditions of a conjunction are checked against the same fault.
+		{name: "same fault", text: "code=OperationFailed and ctx.tenant=acme", fault: wrapped, want: false},
+		{name: "or", text: "code=OperationFailed or ctx.tenant=acme", fault: bad, want: true},
+		{name: "and binds tighter", text: "code=NotFound and msg=x or ctx.tenant", fault: bad, want: true},
+		{name: "parentheses", text: "code=NotFound and (msg=x or ctx.tenant)", fault: bad, want: false},
+
+		// Negations are checked against the whole tree.
+		{name: "not", text: "not code=OperationFailed", fault: bad, want: true},
+		{name: "not of a wrap", text: "not code=BadParameter", fault: wrapped, want: false},
+		{name: "not of a join", text: "not code=BadParameter", fault: joined, want: false},
+		{name: "code differs", text: "code!=BadParameter", fault: joined, want: false},
+		{name: "code differs of a join", text: "code!=NotFound", fault: joined, want: true},
+		{name: "level differs", text: "level!=ERROR", fault: wrapped, want: false},
+		{name: "message differs", text: `msg!="x must be positive"`, fault: joined, want: false},
+		{name: "message does not match", text: `msg!~"save"`, fault: mixed, want: false},
+		{name: "key value differs", text: "ctx.tenant!=acme", fault: wrapped, want: false},
+		{name: "and not", text: "code=BadParameter and not msg~save", fault: mixed, want: false},
+		{name: "double negation", text: "not not code=BadParameter", fault: wrapped, want: true},
+
+		// Sensitive values are compared in their redacted form.
+		{name: "sensitive key exists", text: "ctx.token", fault: bad, want: true},
+		{name: "sensitive value", text: "ctx.token=s3cr3t", fault: bad, want: false},
+		{name: "sensitive value pattern", text: `ctx.token~"^s3"`, fault: bad, want: false},
+		{name: "redacted value", text: "ctx.token=" + `"` + flt.Redacted + `"`, fault: bad, want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			q, err := faults.ParseQuery(tt.text)
+			if err != nil {
+				t.Fatalf("ParseQuery: %s", faults.MessageOf(err))
+			}
+
+			if got := q.Matches(tt.fault); got != tt.want {
+				t.Errorf("got %t, want %t", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestQueryMatchesNil(t *testing.T) {
+	q := faults.MustParseQuery("not code=BadParameter")
+
+	if q.Matches(nil) {
+		t.Error("a query selects the nil fault")
+	}
+}
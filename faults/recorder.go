@@ -0,0 +1,101 @@
+package faults
+
+import (
+	"slices"
+	"sync"
+
+	flt "github.com/PlayerR9/go-fault"
+)
+
+// Recorder is a hook that keeps in memory every event it observes. It is meant to be used
+// in tests and is safe for concurrent use.
+type Recorder struct {
+	// mu guards events.
+	mu sync.Mutex
+
+	// events are the observed events, in order.
+	events []flt.Event
+}
+
+// NewRecorder creates a new, empty, Recorder.
+//
+// Returns:
+//   - *Recorder: The new Recorder. Never returns nil.
+func NewRecorder() *Recorder {
+	return &Recorder{}
+}
+
+// Record records the event. It is a flt.Hook.
+//
+// Parameters:
+//   - event: The event to record.
+func (r *Recorder) Record(event flt.Event) {
+	if r == nil {
+		return
+	}
+
+	r.mu.Lock()
+	r.events = append(r.events, event)
+	r.mu.Unlock()
+}
+
+// Attach subscribes the recorder to the given hooks.
+//
+// Parameters:
+//   - hooks: The hooks to subscribe to. If nil, flt.GlobalHooks is used.
+//
+// Returns:
+//   - func(): The function that detaches the recorder. Never returns nil.
+//
+// Example:
+//
+//	rec := faults.NewRecorder()
+//	defer rec.Attach(nil)()
+func (r *Recorder) Attach(hooks *flt.Hooks) func() {
+	if hooks == nil {
+		hooks = flt.GlobalHooks
+	}
+
+	return hooks.Subscribe(r.Record)
+}
+
+// Events returns a copy of the recorded events.
+//
+// Parameters:
+//   - kinds: The kinds of events to return. If empty, all events are returned.
+//
+// Returns:
+//   - []flt.Event: The recorded events, in order.
+func (r *Recorder) Events(kinds ...flt.EventKind) []flt.Event {
+	if r == nil {
+		return nil
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if len(kinds) == 0 {
+		return slices.Clone(r.events)
+	}
+
+	var events []flt.Event
+
+	for _, event := range r.events {
+		if slices.Contains(kinds, event.Kind) {
+			events = append(events, event)
+		}
+	}
+
+	return events
+}
+
+// Reset forgets all the recorded events.
+func (r *Recorder) Reset() {
+	if r == nil {
+		return
+	}
+
+	r.mu.Lock()
+	r.events = nil
+	r.mu.Unlock()
+}
@@ -0,0 +1,640 @@
+package faults
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"slices"
+	"sync"
+	"time"
+
+	flt "github.com/PlayerR9/go-fault"
+)
+
+// Report is what a Reporter hands to its sink. It is either the first occurrence of a
+// fault within a window or a summary of the occurrences that were held back.
+type Report struct {
+	// Fault is the reported fault. For summaries, it is the last held back occurrence.
+	Fault flt.Fault
+
+	// Fingerprint is the fingerprint of the fault. (See Fingerprint.)
+	Fingerprint string
+
+	// Repeated is the number of occurrences that the summary stands for. Zero for first
+	// occurrences.
+	Repeated int
+
+	// FirstSeen is the time of the first occurrence that the report stands for.
+	FirstSeen time.Time
+
+	// LastSeen is the time of the last occurrence that the report stands for.
+	LastSeen time.Time
+}
+
+// IsSummary checks whether the report is a summary of held back occurrences.
+//
+// Returns:
+//   - bool: True if the report is a summary, false if it is a first occurrence.
+func (r Report) IsSummary() bool {
+	return r.Repeated > 0
+}
+
+// Lines returns the report as a list of strings. First occurrences are rendered with
+// LinesOf while summaries only consist of the fault's message followed by the number of
+// repetitions.
+//
+// Returns:
+//   - []string: The lines of the report.
+func (r Report) Lines() []string {
+	if !r.IsSummary() {
+		return LinesOf(r.Fault)
+	}
+
+	line := fmt.Sprintf("%s (repeated %d times between %s and %s).",
+		ErrorOf(r.Fault),
+		r.Repeated,
+		r.FirstSeen.Format(time.RFC3339),
+		r.LastSeen.Format(time.RFC3339),
+	)
+
+	return []string{line}
+}
+
+// reportJSON is the JSON encoding of a Report.
+type reportJSON struct {
+	Record
+
+	// Repeated is the number of occurrences that the summary stands for.
+	Repeated int `json:"repeated,omitempty"`
+
+	// FirstSeen is the time of the first occurrence that the summary stands for.
+	FirstSeen *time.Time `json:"first_seen,omitempty"`
+
+	// LastSeen is the time of the last occurrence that the summary stands for.
+	LastSeen *time.Time `json:"last_seen,omitempty"`
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+//
+// The report is encoded as the Record of its fault with, for summaries, the additional
+// "repeated", "first_seen" and "last_seen" fields. As such, it can be decoded with
+// DecodeJSON.
+func (r Report) MarshalJSON() ([]byte, error) {
+	tmp := reportJSON{
+		Record: RecordOf(r.Fault),
+	}
+
+	if r.IsSummary() {
+		tmp.Repeated = r.Repeated
+		tmp.FirstSeen = &r.FirstSeen
+		tmp.LastSeen = &r.LastSeen
+	}
+
+	return json.Marshal(tmp)
+}
+
+// Sink is the destination of the reports of a Reporter.
+type Sink interface {
+	// Send sends the report.
+	//
+	// Parameters:
+	//   - report: The report to send.
+	//
+	// Returns:
+	//   - flt.Fault: The fault that occurred while sending, if any.
+	Send(report Report) flt.Fault
+}
+
+// SinkFunc is a function that implements the Sink interface.
+type SinkFunc func(report Report) flt.Fault
+
+// Send implements the Sink interface.
+func (fn SinkFunc) Send(report Report) flt.Fault {
+	return fn(report)
+}
+
+// TextSink returns a sink that writes the lines of the reports to w. (See Report.Lines.)
+//
+// Parameters:
+//   - w: The writer to write to. If nil, io.Discard is used.
+//
+// Returns:
+//   - Sink: The sink. Never returns nil.
+func TextSink(w io.Writer) Sink {
+	if w == nil {
+		w = io.Discard
+	}
+
+	var mu sync.Mutex
+
+	return SinkFunc(func(report Report) flt.Fault {
+		var data []byte
+
+		for _, line := range report.Lines() {
+			data = append(data, line...)
+			data = append(data, '\n')
+		}
+
+		mu.Lock()
+		defer mu.Unlock()
+
+		_, err := w.Write(data)
+		if err != nil {
+			return FromErrWithMsg(err, "could not write the report")
+		}
+
+		return nil
+	})
+}
+
+// JSONSink returns a sink that writes each report to w as a line of JSON. (See
+// Report.MarshalJSON.)
+//
+// Parameters:
+//   - w: The writer to write to. If nil, io.Discard is used.
+//
+// Returns:
+//   - Sink: The sink. Never returns nil.
+func JSONSink(w io.Writer) Sink {
+	if w == nil {
+		w = io.Discard
+	}
+
+	var mu sync.Mutex
+
+	return SinkFunc(func(report Report) flt.Fault {
+		data, err := json.Marshal(report)
+		if err != nil {
+			return FromErrWithMsg(err, "could not encode the report in JSON")
+		}
+
+		data = append(data, '\n')
+
+		mu.Lock()
+		defer mu.Unlock()
+
+		_, err = w.Write(data)
+		if err != nil {
+			return FromErrWithMsg(err, "could not write the report")
+		}
+
+		return nil
+	})
+}
+
+// ReporterOption is an option of a Reporter.
+type ReporterOption func(r *Reporter)
+
+// WithWindow sets the window within which identical faults are aggregated. Defaults to
+// DefaultWindow.
+//
+// Parameters:
+//   - window: The window. Non-positive values disable the aggregation.
+//
+// Returns:
+//   - ReporterOption: The option. Never returns nil.
+func WithWindow(window time.Duration) ReporterOption {
+	return func(r *Reporter) {
+		r.window = window
+	}
+}
+
+// WithNow sets the function that gives the current time. Defaults to flt.Now; which
+// follows the global clock. (See flt.SetClock.) It is mostly meant for tests.
+//
+// Parameters:
+//   - now: The function that gives the current time. If nil, flt.Now is used.
+//
+// Returns:
+//   - ReporterOption: The option. Never returns nil.
+func WithNow(now func() time.Time) ReporterOption {
+	return func(r *Reporter) {
+		if now == nil {
+			now = flt.Now
+		}
+
+		r.now = now
+	}
+}
+
+// WithRateLimit limits the number of faults of the given code that are sent immediately.
+// The faults that exceed the limit are held back and accounted for in the summaries.
+//
+// Codes are compared by type and name; as such, the faults restored from a Record share
+// the limit of their original code and codes of any type, comparable or not, can be used.
+//
+// Parameters:
+//   - code: The code of the faults to limit. (e.g., flt.BadParameter)
+//   - per_second: The number of faults per second that are sent immediately.
+//   - burst: The number of faults that can be sent at once.
+//
+// Returns:
+//   - ReporterOption: The option. Never returns nil.
+func WithRateLimit(code fmt.Stringer, per_second float64, burst int) ReporterOption {
+	return func(r *Reporter) {
+		r.limits[codeKeyOf(code)] = &bucket{
+			rate:   per_second,
+			burst:  float64(burst),
+			tokens: float64(burst),
+		}
+	}
+}
+
+// codeKey identifies a code in the rate limits of a Reporter. Codes are identified by
+// their type and name rather than by themselves; so that codes of non-comparable types do
+// not make the lookups panic and restored faults share the limits of their original code.
+type codeKey struct {
+	// typ is the Go type of the code. (See codeTypeOf.)
+	typ string
+
+	// name is the string representation of the code.
+	name string
+}
+
+// codeKeyOf returns the key of a code.
+//
+// Parameters:
+//   - code: The code.
+//
+// Returns:
+//   - codeKey: The key of the code. The zero key if code is nil.
+func codeKeyOf(code fmt.Stringer) codeKey {
+	if code == nil {
+		return codeKey{}
+	}
+
+	typ, _ := codeTypeOf(code)
+
+	return codeKey{
+		typ:  typ,
+		name: code.String(),
+	}
+}
+
+// bucket is a token bucket.
+type bucket struct {
+	// rate is the number of tokens added per second.
+	rate float64
+
+	// burst is the capacity of the bucket.
+	burst float64
+
+	// tokens is the number of tokens currently in the bucket.
+	tokens float64
+
+	// last is the last time the bucket was refilled.
+	last time.Time
+}
+
+// take takes a token from the bucket.
+//
+// Parameters:
+//   - now: The current time.
+//
+// Returns:
+//   - bool: True if a token was taken, false if the bucket is empty.
+func (b *bucket) take(now time.Time) bool {
+	if !b.last.IsZero() {
+		elapsed := now.Sub(b.last).Seconds()
+		if elapsed > 0 {
+			b.tokens = min(b.burst, b.tokens+elapsed*b.rate)
+		}
+	}
+
+	b.last = now
+
+	if b.tokens < 1 {
+		return false
+	}
+
+	b.tokens--
+
+	return true
+}
+
+// group is the aggregation of the occurrences of a fingerprint within a window.
+type group struct {
+	// start is the start of the window.
+	start time.Time
+
+	// last is the last held back occurrence. Nil if there is none.
+	last flt.Fault
+
+	// held is the number of held back occurrences.
+	held int
+
+	// first_seen is the time of the first held back occurrence.
+	first_seen time.Time
+
+	// last_seen is the time of the last held back occurrence.
+	last_seen time.Time
+}
+
+const (
+	// DefaultWindow is the default window of a Reporter.
+	DefaultWindow time.Duration = time.Minute
+)
+
+// Reporter deduplicates and rate-limits faults before sending them to a sink.
+//
+// Faults are grouped by fingerprint. (See Fingerprint.) The first occurrence of a group
+// within a window is sent immediately; the following ones are held back and, once the
+// window is over, sent as a single summary that says how many times the fault was
+// repeated. It is safe for concurrent use.
+//
+// The summaries are sent when the windows that are over are swept; which Report does, at
+// most once per window, as it starts new groups. As such, a summary may be delayed until
+// other faults are reported. Callers that need timely summaries should call Flush
+// periodically, e.g. from a time.Ticker, and Close on shutdown:
+//
+//	ticker := time.NewTicker(faults.DefaultWindow)
+//	defer ticker.Stop()
+//
+//	for range ticker.C {
+//		_ = reporter.Flush()
+//	}
+type Reporter struct {
+	// mu guards the fields below.
+	mu sync.Mutex
+
+	// sink is the destination of the reports.
+	sink Sink
+
+	// window is the window within which identical faults are aggregated.
+	window time.Duration
+
+	// now gives the current time.
+	now func() time.Time
+
+	// limits are the rate limits, by code.
+	limits map[codeKey]*bucket
+
+	// groups are the aggregations, by fingerprint.
+	groups map[string]*group
+
+	// swept is the last time the groups whose window is over were removed.
+	swept time.Time
+}
+
+// NewReporter creates a new Reporter.
+//
+// Parameters:
+//   - sink: The destination of the reports. If nil, the reports are discarded.
+//   - opts: The options of the reporter.
+//
+// Returns:
+//   - *Reporter: The new Reporter. Never returns nil.
+func NewReporter(sink Sink, opts ...ReporterOption) *Reporter {
+	if sink == nil {
+		sink = SinkFunc(func(Report) flt.Fault { return nil })
+	}
+
+	r := &Reporter{
+		sink:   sink,
+		window: DefaultWindow,
+		now:    flt.Now,
+		limits: make(map[codeKey]*bucket),
+		groups: make(map[string]*group),
+	}
+
+	for _, opt := range opts {
+		opt(r)
+	}
+
+	return r
+}
+
+// Report reports the fault. It is either sent immediately or held back until the end of
+// its window.
+//
+// Parameters:
+//   - fault: The fault to report. Does nothing if nil.
+//
+// Returns:
+//   - flt.Fault: The fault returned by the sink, if any.
+func (r *Reporter) Report(fault flt.Fault) flt.Fault {
+	if fault == nil {
+		return nil
+	} else if r == nil {
+		return NewNilReceiver()
+	}
+
+	fingerprint := Fingerprint(fault)
+
+	r.mu.Lock()
+
+	now := r.now()
+
+	var pending []Report
+
+	g, ok := r.groups[fingerprint]
+	if ok && r.expired(now)(g) {
+		report, ok := g.summary(fingerprint)
+		if ok {
+			pending = append(pending, report)
+		}
+
+		delete(r.groups, fingerprint)
+		g = nil
+	}
+
+	if g == nil {
+		if r.window <= 0 || now.Sub(r.swept) >= r.window {
+			// Sweeping on insert keeps the groups bounded by the fingerprints seen over
+			// the last two windows; even if Flush is never called.
+			pending = append(pending, r.collect(r.expired(now))...)
+			r.swept = now
+		}
+
+		g = &group{
+			start: now,
+		}
+
+		r.groups[fingerprint] = g
+
+		if r.allow(fault, now) {
+			pending = append(pending, Report{
+				Fault:       fault,
+				Fingerprint: fingerprint,
+				FirstSeen:   now,
+				LastSeen:    now,
+			})
+		} else {
+			g.hold(fault, now)
+		}
+	} else {
+		g.hold(fault, now)
+	}
+
+	r.mu.Unlock()
+
+	return r.send(pending)
+}
+
+// Flush sends the summaries of the windows that are over.
+//
+// Returns:
+//   - flt.Fault: The faults returned by the sink, if any.
+func (r *Reporter) Flush() flt.Fault {
+	if r == nil {
+		return NewNilReceiver()
+	}
+
+	r.mu.Lock()
+
+	now := r.now()
+
+	pending := r.collect(r.expired(now))
+	r.swept = now
+
+	r.mu.Unlock()
+
+	return r.send(pending)
+}
+
+// Close sends the summaries of all the windows; whether they are over or not.
+//
+// Returns:
+//   - flt.Fault: The faults returned by the sink, if any.
+func (r *Reporter) Close() flt.Fault {
+	if r == nil {
+		return NewNilReceiver()
+	}
+
+	r.mu.Lock()
+
+	pending := r.collect(func(*group) bool {
+		return true
+	})
+
+	r.mu.Unlock()
+
+	return r.send(pending)
+}
+
+// expired returns the predicate of the groups whose window is over.
+//
+// Parameters:
+//   - now: The current time.
+//
+// Returns:
+//   - func(g *group) bool: The predicate. Never returns nil.
+func (r *Reporter) expired(now time.Time) func(g *group) bool {
+	return func(g *group) bool {
+		return r.window <= 0 || now.Sub(g.start) >= r.window
+	}
+}
+
+// collect removes the groups that satisfy the predicate and returns their summaries,
+// sorted by their first held back occurrence.
+//
+// Parameters:
+//   - pred: The predicate.
+//
+// Returns:
+//   - []Report: The summaries.
+func (r *Reporter) collect(pred func(g *group) bool) []Report {
+	var pending []Report
+
+	for fingerprint, g := range r.groups {
+		if !pred(g) {
+			continue
+		}
+
+		report, ok := g.summary(fingerprint)
+		if ok {
+			pending = append(pending, report)
+		}
+
+		delete(r.groups, fingerprint)
+	}
+
+	slices.SortFunc(pending, func(a, b Report) int {
+		return a.FirstSeen.Compare(b.FirstSeen)
+	})
+
+	return pending
+}
+
+// allow checks whether the fault can be sent immediately according to the rate limit of
+// its code.
+//
+// Parameters:
+//   - fault: The fault to check.
+//   - now: The current time.
+//
+// Returns:
+//   - bool: True if the fault can be sent immediately, false otherwise.
+func (r *Reporter) allow(fault flt.Fault, now time.Time) bool {
+	if len(r.limits) == 0 {
+		return true
+	}
+
+	b, ok := r.limits[codeKeyOf(DescriptorOf(fault).Code())]
+	if !ok {
+		return true
+	}
+
+	return b.take(now)
+}
+
+// send sends the reports to the sink.
+//
+// Parameters:
+//   - reports: The reports to send.
+//
+// Returns:
+//   - flt.Fault: The faults returned by the sink, joined. Nil if there are none.
+func (r *Reporter) send(reports []Report) flt.Fault {
+	var faults []flt.Fault
+
+	for _, report := range reports {
+		err := r.sink.Send(report)
+		if err != nil {
+			faults = append(faults, err)
+		}
+	}
+
+	if len(faults) == 1 {
+		return faults[0]
+	}
+
+	return Join(faults...)
+}
+
+// hold holds back an occurrence of the group's fault.
+//
+// Parameters:
+//   - fault: The occurrence.
+//   - now: The time of the occurrence.
+func (g *group) hold(fault flt.Fault, now time.Time) {
+	if g.held == 0 {
+		g.first_seen = now
+	}
+
+	g.held++
+	g.last = fault
+	g.last_seen = now
+}
+
+// summary returns the summary of the held back occurrences of the group.
+//
+// Parameters:
+//   - fingerprint: The fingerprint of the group.
+//
+// Returns:
+//   - Report: The summary.
+//   - bool: True if there are held back occurrences, false otherwise.
+func (g *group) summary(fingerprint string) (Report, bool) {
+	if g.held == 0 {
+		return Report{}, false
+	}
+
+	return Report{
+		Fault:       g.last,
+		Fingerprint: fingerprint,
+		Repeated:    g.held,
+		FirstSeen:   g.first_seen,
+		LastSeen:    g.last_seen,
+	}, true
+}
@@ -0,0 +1,256 @@
+package faults
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	flt "github.com/PlayerR9/go-fault"
+)
+
+// reporterClock is a manual clock for the reporter's options.
+type reporterClock struct {
+	now time.Time
+}
+
+func (c *reporterClock) Now() time.Time {
+	return c.now
+}
+
+// recordingSink records the reports it is sent.
+type recordingSink struct {
+	reports []Report
+}
+
+func (s *recordingSink) Send(report Report) flt.Fault {
+	s.reports = append(s.reports, report)
+	return nil
+}
+
+// summaries returns the number of summaries among the sent reports.
+func (s *recordingSink) summaries() int {
+	var count int
+
+	for _, report := range s.reports {
+		if report.IsSummary() {
+			count++
+		}
+	}
+
+	return count
+}
+
+func TestReporter(t *testing.T) {
+	type event struct {
+		// advance is the time elapsed before the event.
+		advance time.Duration
+
+		// msg is the message of the reported fault. If empty, Flush is called instead.
+		msg string
+	}
+
+	tests := []struct {
+		name      string
+		events    []event
+		sent      int
+		summaries int
+		groups    int
+	}{
+		{
+			name: "held back",
+			events: []event{
+				{msg: "a"},
+				{advance: time.Second, msg: "a"},
+				{advance: time.Second, msg: "a"},
+			},
+			sent:      1,
+			summaries: 0,
+			groups:    1,
+		},
+		{
+			name: "summary on the next occurrence",
+			events: []event{
+				{msg: "a"},
+				{advance: time.Second, msg: "a"},
+				{advance: 2 * time.Minute, msg: "a"},
+			},
+			sent:      3,
+			summaries: 1,
+			groups:    1,
+		},
+		{
+			name: "swept on insert",
+			events: []event{
+				{msg: "a"},
+				{advance: time.Second, msg: "a"},
+				{advance: 2 * time.Minute, msg: "b"},
+			},
+			sent:      3,
+			summaries: 1,
+			groups:    1,
+		},
+		{
+			name: "flushed",
+			events: []event{
+				{msg: "a"},
+				{advance: time.Second, msg: "a"},
+				{advance: 2 * time.Minute},
+			},
+			sent:      2,
+			summaries: 1,
+			groups:    0,
+		},
+		{
+			name: "not flushed within the window",
+			events: []event{
+				{msg: "a"},
+				{advance: time.Second, msg: "a"},
+				{advance: time.Second},
+			},
+			sent:      1,
+			summaries: 0,
+			groups:    1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &reporterClock{now: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)}
+			sink := &recordingSink{}
+
+			r := NewReporter(sink, WithWindow(time.Minute), WithNow(c.Now))
+
+			for _, ev := range tt.events {
+				c.now = c.now.Add(ev.advance)
+
+				var err flt.Fault
+
+				if ev.msg == "" {
+					err = r.Flush()
+				} else {
+					err = r.Report(NewBadParameter(ev.msg))
+				}
+
+				if err != nil {
+					t.Fatalf("unexpected fault: %s", ErrorOf(err))
+				}
+			}
+
+			if len(sink.reports) != tt.sent {
+				t.Errorf("sent %d reports, want %d", len(sink.reports), tt.sent)
+			}
+
+			if got := sink.summaries(); got != tt.summaries {
+				t.Errorf("sent %d summaries, want %d", got, tt.summaries)
+			}
+
+			if len(r.groups) != tt.groups {
+				t.Errorf("kept %d groups, want %d", len(r.groups), tt.groups)
+			}
+		})
+	}
+}
+
+func TestReporterGroupsBounded(t *testing.T) {
+	c := &reporterClock{now: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)}
+
+	r := NewReporter(nil, WithWindow(time.Minute), WithNow(c.Now))
+
+	for i := range 1000 {
+		c.now = c.now.Add(time.Second)
+
+		_ = r.Report(NewBadParameter("fault " + strconv.Itoa(i)))
+
+		// The groups of at most two windows are kept.
+		if len(r.groups) > 120 {
+			t.Fatalf("kept %d groups after %d reports; want at most 120", len(r.groups), i+1)
+		}
+	}
+}
+
+// listCode is a code whose type is not comparable.
+type listCode []string
+
+func (lc listCode) String() string {
+	return strings.Join(lc, "/")
+}
+
+// listDescriber is a descriptor whose code is a listCode.
+type listDescriber struct {
+	flt.FaultDescriber
+
+	// code is the code of the descriptor.
+	code listCode
+}
+
+func (ld listDescriber) Code() fmt.Stringer {
+	return ld.code
+}
+
+func TestReporterRateLimit(t *testing.T) {
+	list := listDescriber{
+		FaultDescriber: flt.NewDescriptor(flt.ERROR, flt.OperationFailed, "x"),
+		code:           listCode{"io", "disk"},
+	}
+
+	tests := []struct {
+		name  string
+		code  fmt.Stringer
+		fault func(i int) flt.Fault
+		sent  int
+	}{
+		{
+			name:  "limited code",
+			code:  flt.BadParameter,
+			fault: func(i int) flt.Fault { return NewBadParameter("item " + strconv.Itoa(i)) },
+			sent:  1,
+		},
+		{
+			name:  "other code",
+			code:  flt.NotFound,
+			fault: func(i int) flt.Fault { return NewBadParameter("item " + strconv.Itoa(i)) },
+			sent:  3,
+		},
+		{
+			name: "restored faults",
+			code: flt.BadParameter,
+			fault: func(i int) flt.Fault {
+				return RecordOf(NewBadParameter("item " + strconv.Itoa(i))).Fault()
+			},
+			sent: 1,
+		},
+		{
+			name: "non-comparable code",
+			code: listCode{"io", "disk"},
+			fault: func(i int) flt.Fault {
+				fault := flt.NewBase(list)
+				_ = AddKey(fault, "item", i)
+
+				return fault
+			},
+			sent: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := &reporterClock{now: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)}
+			sink := &recordingSink{}
+
+			r := NewReporter(sink, WithWindow(time.Minute), WithNow(c.Now), WithRateLimit(tt.code, 0.001, 1))
+
+			for i := range 3 {
+				err := r.Report(tt.fault(i))
+				if err != nil {
+					t.Fatalf("unexpected fault: %s", ErrorOf(err))
+				}
+			}
+
+			if len(sink.reports) != tt.sent {
+				t.Errorf("sent %d reports, want %d", len(sink.reports), tt.sent)
+			}
+		})
+	}
+}
@@ -0,0 +1,278 @@
+package faults
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	flt "github.com/PlayerR9/go-fault"
+)
+
+// ErrNoSuchKey is a fault that indicates that a key does not exist. Its descriptor is
+// NoSuchKey.
+type ErrNoSuchKey struct {
+	flt.Fault
+
+	// Key is the key that does not exist.
+	Key string
+
+	// Known are the keys that do exist, if known.
+	Known []string
+}
+
+// Embeds implements the flt.Fault interface.
+func (e ErrNoSuchKey) Embeds() flt.Fault {
+	return e.Fault
+}
+
+// InfoLines implements the flt.Fault interface.
+//
+// Format:
+//
+//	"- Known keys: <keys>"
+//
+// Where, <keys> is the comma-separated list of the known keys, each quoted. The line is
+// omitted if the known keys are not known.
+func (e ErrNoSuchKey) InfoLines() []string {
+	return e.InfoLinesIn(nil)
+}
+
+// InfoLinesIn implements the flt.LocalizedInfoer interface.
+func (e ErrNoSuchKey) InfoLinesIn(tr flt.Translator) []string {
+	if len(e.Known) == 0 {
+		return nil
+	}
+
+	quoted := make([]string, 0, len(e.Known))
+
+	for _, key := range e.Known {
+		quoted = append(quoted, strconv.Quote(key))
+	}
+
+	return []string{"- " + translate(tr, "Known keys:") + " " + strings.Join(quoted, ", ")}
+}
+
+// NewErrNoSuchKey creates a new ErrNoSuchKey.
+//
+// Parameters:
+//   - key: The key that does not exist.
+//   - known: The keys that do exist. May be nil.
+//
+// Returns:
+//   - *ErrNoSuchKey: The new ErrNoSuchKey. Never returns nil.
+func NewErrNoSuchKey(key string, known []string, opts ...FaultOption) *ErrNoSuchKey {
+	fault := &ErrNoSuchKey{
+		Fault: flt.NewBase(NoSuchKey),
+		Key:   key,
+		Known: known,
+	}
+
+	_ = Set(fault, MissingKey, key)
+
+	return apply(fault, opts)
+}
+
+// ErrOutOfRange is a fault that indicates that an index is outside of its valid range.
+// Its descriptor is IndexOutOfRange.
+type ErrOutOfRange struct {
+	flt.Fault
+
+	// Index is the index that is out of range.
+	Index int
+
+	// Min is the lower bound of the valid range; inclusive.
+	Min int
+
+	// Max is the upper bound of the valid range; exclusive.
+	Max int
+}
+
+// Embeds implements the flt.Fault interface.
+func (e ErrOutOfRange) Embeds() flt.Fault {
+	return e.Fault
+}
+
+// InfoLines implements the flt.Fault interface.
+//
+// Format:
+//
+//	"- Valid range: [<min>, <max>)"
+func (e ErrOutOfRange) InfoLines() []string {
+	return e.InfoLinesIn(nil)
+}
+
+// InfoLinesIn implements the flt.LocalizedInfoer interface.
+func (e ErrOutOfRange) InfoLinesIn(tr flt.Translator) []string {
+	return []string{fmt.Sprintf("- %s [%d, %d)", translate(tr, "Valid range:"), e.Min, e.Max)}
+}
+
+// NewErrOutOfRange creates a new ErrOutOfRange.
+//
+// Parameters:
+//   - index: The index that is out of range.
+//   - min: The lower bound of the valid range; inclusive.
+//   - max: The upper bound of the valid range; exclusive.
+//
+// Returns:
+//   - *ErrOutOfRange: The new ErrOutOfRange. Never returns nil.
+func NewErrOutOfRange(index, min, max int, opts ...FaultOption) *ErrOutOfRange {
+	fault := &ErrOutOfRange{
+		Fault: flt.NewBase(IndexOutOfRange),
+		Index: index,
+		Min:   min,
+		Max:   max,
+	}
+
+	_ = Set(fault, IndexKey, index)
+
+	return apply(fault, opts)
+}
+
+// ErrTypeMismatch is a fault that indicates that a value does not have the expected
+// type. Its descriptor is TypeMismatch.
+type ErrTypeMismatch struct {
+	flt.Fault
+
+	// Expected is the expected type.
+	Expected reflect.Type
+
+	// Actual is the actual type. Nil if the value is nil.
+	Actual reflect.Type
+}
+
+// Embeds implements the flt.Fault interface.
+func (e ErrTypeMismatch) Embeds() flt.Fault {
+	return e.Fault
+}
+
+// InfoLines implements the flt.Fault interface.
+//
+// The types are already part of the message; as such, no line is added.
+func (e ErrTypeMismatch) InfoLines() []string {
+	return nil
+}
+
+// NewErrTypeMismatch creates a new ErrTypeMismatch.
+//
+// Parameters:
+//   - expected: The expected type.
+//   - actual: The actual type. Nil if the value is nil.
+//
+// Returns:
+//   - *ErrTypeMismatch: The new ErrTypeMismatch. Never returns nil.
+//
+// Example:
+//
+//	err := faults.NewErrTypeMismatch(reflect.TypeFor[int](), reflect.TypeOf(value))
+func NewErrTypeMismatch(expected, actual reflect.Type, opts ...FaultOption) *ErrTypeMismatch {
+	fault := &ErrTypeMismatch{
+		Fault:    flt.NewBase(TypeMismatch),
+		Expected: expected,
+		Actual:   actual,
+	}
+
+	_ = Set(fault, ExpectedTypeKey, typeName(expected))
+	_ = Set(fault, ActualTypeKey, typeName(actual))
+
+	return apply(fault, opts)
+}
+
+// typeName returns the name of the type.
+//
+// Parameters:
+//   - typ: The type.
+//
+// Returns:
+//   - string: The name of the type. "<nil>" if typ is nil.
+func typeName(typ reflect.Type) string {
+	if typ == nil {
+		return "<nil>"
+	}
+
+	return typ.String()
+}
+
+// ErrNilParameter is a fault that indicates that a parameter is nil. Its descriptor is
+// NilParameter.
+type ErrNilParameter struct {
+	flt.Fault
+
+	// Name is the name of the parameter.
+	Name string
+}
+
+// Embeds implements the flt.Fault interface.
+func (e ErrNilParameter) Embeds() flt.Fault {
+	return e.Fault
+}
+
+// InfoLines implements the flt.Fault interface.
+//
+// The name of the parameter is already part of the message; as such, no line is added.
+func (e ErrNilParameter) InfoLines() []string {
+	return nil
+}
+
+// ErrTimeout is a fault that indicates that an operation did not complete before its
+// deadline. Its descriptor is TimedOut.
+type ErrTimeout struct {
+	flt.Fault
+
+	// Op is the name of the operation.
+	Op string
+
+	// Elapsed is the time the operation ran for before it was given up on.
+	Elapsed time.Duration
+
+	// Limit is the time the operation was allowed to run for.
+	Limit time.Duration
+}
+
+// Embeds implements the flt.Fault interface.
+func (e ErrTimeout) Embeds() flt.Fault {
+	return e.Fault
+}
+
+// InfoLines implements the flt.Fault interface.
+//
+// Format:
+//
+//	"- Elapsed: <elapsed>"
+//	"- Limit: <limit>"
+func (e ErrTimeout) InfoLines() []string {
+	return e.InfoLinesIn(nil)
+}
+
+// InfoLinesIn implements the flt.LocalizedInfoer interface.
+func (e ErrTimeout) InfoLinesIn(tr flt.Translator) []string {
+	lines := make([]string, 0, 2)
+
+	lines = append(lines, "- "+translate(tr, "Elapsed:")+" "+e.Elapsed.String())
+	lines = append(lines, "- "+translate(tr, "Limit:")+" "+e.Limit.String())
+
+	return lines
+}
+
+// NewErrTimeout creates a new ErrTimeout.
+//
+// Parameters:
+//   - op: The name of the operation. (e.g., "GET /users")
+//   - elapsed: The time the operation ran for.
+//   - limit: The time the operation was allowed to run for.
+//
+// Returns:
+//   - *ErrTimeout: The new ErrTimeout. Never returns nil.
+func NewErrTimeout(op string, elapsed, limit time.Duration, opts ...FaultOption) *ErrTimeout {
+	fault := &ErrTimeout{
+		Fault:   flt.NewBase(TimedOut),
+		Op:      op,
+		Elapsed: elapsed,
+		Limit:   limit,
+	}
+
+	_ = Set(fault, OperationKey, op)
+
+	return apply(fault, opts)
+}
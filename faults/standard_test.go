@@ -0,0 +1,187 @@
+package faults_test
+
+import (
+	"reflect"
+	"slices"
+	"testing"
+	"time"
+
+	flt "github.com/PlayerR9/go-fault"
+	"github.com/PlayerR9/go-fault/faults"
+)
+
+// asTyped checks that the typed fault is retrieved by AsType from a fault wrapping it, and
+// returns it.
+func asTyped[T flt.Fault](tb testing.TB, fault flt.Fault) T {
+	tb.Helper()
+
+	got, ok := faults.AsType[T](faults.Wrap(fault, flt.OperationFailed, "w"))
+	if !ok {
+		tb.Fatalf("got no %T from the wrapping fault, want one", *new(T))
+	}
+
+	if flt.Fault(got) != fault {
+		tb.Errorf("got %v from the wrapping fault, want the fault", got)
+	}
+
+	return got
+}
+
+// hasValue checks that the typed key of the fault holds the value.
+func hasValue[T comparable](tb testing.TB, fault flt.Fault, key faults.Key[T], want T) {
+	tb.Helper()
+
+	got, ok := faults.Get(fault, key)
+	if !ok || got != want {
+		tb.Errorf("%s: got %v and %t, want %v and true", key, got, ok, want)
+	}
+}
+
+func TestStandardFaults(t *testing.T) {
+	fr := faults.NewLocalizer(nil, "fr")
+
+	tests := []struct {
+		name  string
+		fault flt.Fault
+		msg   string
+		lines []string
+		fr    []string
+		check func(t *testing.T, fault flt.Fault)
+	}{
+		{
+			name:  "no such key",
+			fault: faults.NewErrNoSuchKey("id", []string{"name", "age"}),
+			msg:   `the specified key ("id") does not exist`,
+			lines: []string{`- Known keys: "name", "age"`},
+			fr:    []string{`- Clés connues : "name", "age"`},
+			check: func(t *testing.T, fault flt.Fault) {
+				got := asTyped[*faults.ErrNoSuchKey](t, fault)
+
+				if got.Key != "id" || !slices.Equal(got.Known, []string{"name", "age"}) {
+					t.Errorf("got the key %q and the known keys %q", got.Key, got.Known)
+				}
+
+				hasValue(t, fault, faults.MissingKey, "id")
+			},
+		},
+		{
+			name:  "no such key without known keys",
+			fault: faults.NewNoSuchKey("id"),
+			msg:   `the specified key ("id") does not exist`,
+			lines: nil,
+			fr:    nil,
+			check: func(t *testing.T, fault flt.Fault) {
+				_ = asTyped[*faults.ErrNoSuchKey](t, fault)
+
+				hasValue(t, fault, faults.MissingKey, "id")
+			},
+		},
+		{
+			name:  "out of range",
+			fault: faults.NewErrOutOfRange(7, 0, 5),
+			msg:   "index (7) is out of range",
+			lines: []string{"- Valid range: [0, 5)"},
+			fr:    []string{"- Plage valide : [0, 5)"},
+			check: func(t *testing.T, fault flt.Fault) {
+				got := asTyped[*faults.ErrOutOfRange](t, fault)
+
+				if got.Index != 7 || got.Min != 0 || got.Max != 5 {
+					t.Errorf("got the index %d in [%d, %d)", got.Index, got.Min, got.Max)
+				}
+
+				hasValue(t, fault, faults.IndexKey, 7)
+			},
+		},
+		{
+			name:  "type mismatch",
+			fault: faults.NewErrTypeMismatch(reflect.TypeFor[int](), reflect.TypeFor[string]()),
+			msg:   "expected a value of type int, got one of type string",
+			lines: nil,
+			fr:    nil,
+			check: func(t *testing.T, fault flt.Fault) {
+				got := asTyped[*faults.ErrTypeMismatch](t, fault)
+
+				if got.Expected != reflect.TypeFor[int]() || got.Actual != reflect.TypeFor[string]() {
+					t.Errorf("got the types %v and %v", got.Expected, got.Actual)
+				}
+
+				hasValue(t, fault, faults.ExpectedTypeKey, "int")
+				hasValue(t, fault, faults.ActualTypeKey, "string")
+			},
+		},
+		{
+			name:  "type mismatch of nil",
+			fault: faults.NewErrTypeMismatch(reflect.TypeFor[int](), nil),
+			msg:   "expected a value of type int, got one of type <nil>",
+			lines: nil,
+			fr:    nil,
+			check: func(t *testing.T, fault flt.Fault) {
+				got := asTyped[*faults.ErrTypeMismatch](t, fault)
+
+				if got.Actual != nil {
+					t.Errorf("got the actual type %v, want nil", got.Actual)
+				}
+
+				hasValue(t, fault, faults.ActualTypeKey, "<nil>")
+			},
+		},
+		{
+			name:  "nil parameter",
+			fault: faults.NewNilParameter("cfg"),
+			msg:   `parameter ("cfg") must be non-nil`,
+			lines: nil,
+			fr:    nil,
+			check: func(t *testing.T, fault flt.Fault) {
+				got := asTyped[*faults.ErrNilParameter](t, fault)
+
+				if got.Name != "cfg" {
+					t.Errorf("got the name %q, want %q", got.Name, "cfg")
+				}
+
+				hasValue(t, fault, faults.ParameterKey, "cfg")
+			},
+		},
+		{
+			name:  "timeout",
+			fault: faults.NewErrTimeout("GET /users", 1500*time.Millisecond, time.Second),
+			msg:   `operation ("GET /users") timed out`,
+			lines: []string{"- Elapsed: 1.5s", "- Limit: 1s"},
+			fr:    []string{"- Écoulé : 1.5s", "- Limite : 1s"},
+			check: func(t *testing.T, fault flt.Fault) {
+				got := asTyped[*faults.ErrTimeout](t, fault)
+
+				if got.Op != "GET /users" || got.Elapsed != 1500*time.Millisecond || got.Limit != time.Second {
+					t.Errorf("got the operation %q, ran for %s of %s", got.Op, got.Elapsed, got.Limit)
+				}
+
+				hasValue(t, fault, faults.OperationKey, "GET /users")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := faults.MessageOf(tt.fault); got != tt.msg {
+				t.Errorf("got the message %q, want %q", got, tt.msg)
+			}
+
+			if got := tt.fault.InfoLines(); !slices.Equal(got, tt.lines) {
+				t.Errorf("InfoLines: got %q, want %q", got, tt.lines)
+			}
+
+			// The types whose lines are not translated do not implement flt.LocalizedInfoer.
+			got := tt.fault.InfoLines()
+
+			infoer, ok := tt.fault.(flt.LocalizedInfoer)
+			if ok {
+				got = infoer.InfoLinesIn(fr)
+			}
+
+			if !slices.Equal(got, tt.fr) {
+				t.Errorf("InfoLinesIn: got %q, want %q", got, tt.fr)
+			}
+
+			tt.check(t, tt.fault)
+		})
+	}
+}
@@ -21,7 +21,9 @@ func init() {
 }
 
 // Traverse traverses the fault tree in a DFS manner and executes the function on each fault;
-// stopping at the first time the function returns true.
+// stopping at the first time the function returns true. The children of a fault are its
+// cause and the faults it joins; which are looked for in its whole embedding tower. Each
+// fault is visited once. (See Walk.)
 //
 // Parameters:
 //   - fault: The fault to traverse.
@@ -37,92 +39,69 @@ func Traverse(fault flt.Fault, fn func(fault flt.Fault) bool) bool {
 		return false
 	}
 
-	stack := []flt.Fault{fault}
-
-	for len(stack) > 0 {
-		top := stack[len(stack)-1]
-		stack = stack[:len(stack)-1]
-
-		if fn(top) {
+	for f := range All(fault, Following(CauseRelation|JoinRelation)) {
+		if fn(f) {
 			return true
 		}
-
-		switch fault := top.(type) {
-		case interface{ Unwrap() flt.Fault }:
-			inner := fault.Unwrap()
-			if inner != nil {
-				stack = append(stack, inner)
-			}
-		case interface{ Unwrap() []flt.Fault }:
-			inners := fault.Unwrap()
-
-			if len(inners) > 0 {
-				var top int
-
-				for i := 0; i < len(inners); i++ {
-					if inners[i] != nil {
-						inners[top] = inners[i]
-						top++
-					}
-				}
-
-				inners = inners[:top:top]
-
-				stack = append(stack, inners...)
-			}
-		}
 	}
 
 	return false
 }
 
-// Is checks whether a fault or any fault it may wrap is equal to the target fault. The
-// search is done in a depth-first manner and it doesn't scan the fault's embedding tower.
+// Is checks whether a fault or any fault it may wrap is the target fault. The search is
+// done as by Traverse; that is, through the causes and the joined faults.
 //
-// The comparison is done using pointer equality and the Is() method of the target fault. When
-// either is true, the function returns true.
+// A fault is the target if a layer of its embedding tower is the same fault as the target
+// (pointer equality, or equal contents for faults that are values) or has an
+// Is(flt.Fault) bool method that reports so. Descriptors are not compared; use Match with
+// the Descriptor or Code matchers for that.
 //
 // Parameters:
 //   - fault: The fault to check.
 //   - target: The fault to compare with.
 //
 // Returns:
-//   - bool: True if the fault (or any fault it may wrap) is equal to the target fault, false
+//   - bool: True if the fault (or any fault it may wrap) is the target fault, false
 //     otherwise.
 func Is(fault flt.Fault, target flt.Fault) bool {
 	if fault == nil || target == nil {
 		return false
 	}
 
-	target_desc := DescriptorOf(target)
+	target_key := keyOf(target)
 
 	ok := Traverse(fault, func(f flt.Fault) bool {
-		f_desc := DescriptorOf(f)
+		for elem := f; elem != nil; elem = elem.Embeds() {
+			if keyOf(elem) == target_key {
+				return true
+			}
 
-		if f == target || f_desc == target_desc {
-			return true
+			is, ok := elem.(interface{ Is(flt.Fault) bool })
+			if ok && is.Is(target) {
+				return true
+			}
 		}
 
-		_, ok := f.(interface{ Is(flt.Fault) bool })
-		return ok
+		return false
 	})
 
 	return ok
 }
 
-// As checks whether a fault or any fault it may wrap implements the target interface. The
-// search is done in a depth-first manner and it doesn't scan the fault's embedding tower.
+// As checks whether a fault or any fault it leads to is of the target's type and, if so,
+// sets the target to it. The faults are visited as by Walk; that is, the layers of the
+// embedding towers, the causes and the joined faults.
 //
-// The comparison is done using pointer equality and the As() method of the target interface. When
-// either is true, the function returns true and the target is set to the fault.
+// A fault also matches if it has an As(any) bool method that reports so; in which case,
+// the method is responsible for setting the target.
 //
 // Parameters:
 //   - fault: The fault to check.
-//   - target: The target to set if the fault implements the target interface.
+//   - target: A non-nil pointer to a type that implements flt.Fault; either a concrete
+//     type or an interface that embeds flt.Fault. For other types, use AsType.
 //
 // Returns:
-//   - bool: True if the fault (or any fault it may wrap) implements the target interface, false
-//     otherwise.
+//   - bool: True if a fault matches, false otherwise; including if the target is invalid.
 func As(fault flt.Fault, target any) bool {
 	if fault == nil || target == nil {
 		return false
@@ -136,11 +115,11 @@ func As(fault flt.Fault, target any) bool {
 	}
 
 	type_ := target_type.Elem()
-	if type_.Kind() != reflect.Interface && !type_.Implements(_FaultType) {
+	if !type_.Implements(_FaultType) {
 		return false
 	}
 
-	return Traverse(fault, func(f flt.Fault) bool {
+	for f := range All(fault) {
 		if reflect.TypeOf(f).AssignableTo(type_) {
 			target_value.Elem().Set(reflect.ValueOf(f))
 
@@ -148,6 +127,66 @@ func As(fault flt.Fault, target any) bool {
 		}
 
 		e, ok := f.(interface{ As(any) bool })
-		return ok && e.As(target)
-	})
+		if ok && e.As(target) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// AsType is like As but generic; as such, T can be any type. (e.g., an interface that
+// does not embed flt.Fault)
+//
+// The embedding tower of the fault is searched first, with plain type assertions; which
+// is the common case. Only then are its causes and joined faults searched, as by Walk.
+//
+// Parameters:
+//   - fault: The fault to check.
+//
+// Returns:
+//   - T: The first fault of type T. The zero value if none is.
+//   - bool: True if a fault of type T was found, false otherwise.
+//
+// Example:
+//
+//	nsk, ok := faults.AsType[*faults.ErrNoSuchKey](fault)
+func AsType[T any](fault flt.Fault) (T, bool) {
+	for elem := fault; elem != nil; elem = elem.Embeds() {
+		v, ok := elem.(T)
+		if ok {
+			return v, true
+		}
+	}
+
+	for f := range All(fault) {
+		v, ok := f.(T)
+		if ok {
+			return v, true
+		}
+	}
+
+	return *new(T), false
+}
+
+// FindAll returns all the faults of type T that a fault leads to; including itself. The
+// faults are visited as by Walk; that is, the layers of the embedding towers, the causes
+// and the joined faults.
+//
+// Parameters:
+//   - fault: The fault to search.
+//
+// Returns:
+//   - []T: The faults of type T, in the order of Walk. Nil if there are none.
+func FindAll[T any](fault flt.Fault) []T {
+	var elems []T
+
+	for f := range All(fault) {
+		v, ok := f.(T)
+		if ok {
+			elems = append(elems, v)
+		}
+	}
+
+	return elems
 }
@@ -0,0 +1,243 @@
+package faults_test
+
+import (
+	"testing"
+
+	flt "github.com/PlayerR9/go-fault"
+	"github.com/PlayerR9/go-fault/faults"
+)
+
+// sentinel is a fault whose Is method reports the targets equal to want; recording the
+// targets it is called with.
+type sentinel struct {
+	flt.Fault
+
+	// want is the target the fault is.
+	want flt.Fault
+
+	// targets are the targets Is was called with.
+	targets []flt.Fault
+}
+
+func (s *sentinel) Embeds() flt.Fault {
+	return s.Fault
+}
+
+func (s *sentinel) InfoLines() []string {
+	return nil
+}
+
+func (s *sentinel) Is(target flt.Fault) bool {
+	s.targets = append(s.targets, target)
+
+	return target == s.want
+}
+
+// named is a fault that is a value; so that two of them are the same fault if their
+// contents are equal.
+type named struct {
+	flt.Fault
+
+	// name is the name of the fault.
+	name string
+}
+
+func (n named) Embeds() flt.Fault {
+	return n.Fault
+}
+
+func (n named) InfoLines() []string {
+	return nil
+}
+
+func TestIs(t *testing.T) {
+	bad := faults.NewBadParameter("x must be positive")
+	other := faults.NewBadParameter("x must be positive")
+
+	desc := flt.NewDescriptor(flt.ERROR, flt.BadParameter, "x must be positive")
+	first := desc.Init()
+
+	base := faults.NewBadParameter("y must be positive")
+
+	tests := []struct {
+		name   string
+		fault  flt.Fault
+		target flt.Fault
+		want   bool
+	}{
+		{name: "nil fault", fault: nil, target: bad, want: false},
+		{name: "nil target", fault: bad, target: nil, want: false},
+		{name: "itself", fault: bad, target: bad, want: true},
+		{name: "same contents", fault: bad, target: other, want: false},
+		{name: "same descriptor", fault: first, target: desc.Init(), want: false},
+		{name: "distinct News", fault: flt.New(flt.BadParameter, "x"), target: flt.New(flt.BadParameter, "x"), want: false},
+		{name: "cause", fault: faults.Wrap(bad, flt.OperationFailed, "could not parse"), target: bad, want: true},
+		{name: "cause of a cause", fault: faults.Wrap(faults.Wrap(bad, flt.OperationFailed, "w"), flt.OperationFailed, "w"), target: bad, want: true},
+		{name: "joined", fault: faults.Join(other, bad), target: bad, want: true},
+		{name: "not joined", fault: faults.Join(other), target: bad, want: false},
+		{name: "embedded layer", fault: layer{Fault: bad}, target: bad, want: true},
+		{name: "the wrapper is not its cause", fault: bad, target: faults.Wrap(bad, flt.OperationFailed, "w"), want: false},
+		{name: "equal values", fault: named{Fault: base, name: "a"}, target: named{Fault: base, name: "a"}, want: true},
+		{name: "distinct values", fault: named{Fault: base, name: "a"}, target: named{Fault: base, name: "b"}, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := faults.Is(tt.fault, tt.target); got != tt.want {
+				t.Errorf("got %t, want %t", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsMethod(t *testing.T) {
+	target := faults.NewNotFound("no such user")
+
+	tests := []struct {
+		name string
+		want flt.Fault
+		is   bool
+	}{
+		{name: "reports the target", want: target, is: true},
+		{name: "reports another fault", want: faults.NewNotFound("no such user"), is: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := &sentinel{Fault: faults.NewBadParameter("x"), want: tt.want}
+
+			if got := faults.Is(faults.Wrap(s, flt.OperationFailed, "w"), target); got != tt.is {
+				t.Errorf("got %t, want %t", got, tt.is)
+			}
+
+			if len(s.targets) == 0 || s.targets[0] != target {
+				t.Errorf("got the Is method called with %v, want it called with the target", s.targets)
+			}
+		})
+	}
+}
+
+func TestAsType(t *testing.T) {
+	key := faults.NewNoSuchKey("id")
+	bad := faults.NewBadParameter("x must be positive")
+
+	tests := []struct {
+		name  string
+		fault flt.Fault
+		want  flt.Fault
+	}{
+		{name: "nil", fault: nil, want: nil},
+		{name: "itself", fault: key, want: key},
+		{name: "embedded layer", fault: layer{Fault: key}, want: key},
+		{name: "cause", fault: faults.Wrap(key, flt.OperationFailed, "could not load"), want: key},
+		{name: "joined", fault: faults.Join(bad, key), want: key},
+		{name: "cause of a joined fault", fault: faults.Join(bad, faults.Wrap(key, flt.OperationFailed, "w")), want: key},
+		{name: "missing", fault: faults.Join(bad), want: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := faults.AsType[*faults.ErrNoSuchKey](tt.fault)
+
+			if ok != (tt.want != nil) {
+				t.Fatalf("got %t, want %t", ok, tt.want != nil)
+			}
+
+			if ok && flt.Fault(got) != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAsTypeInterface(t *testing.T) {
+	wrapped := faults.Wrap(faults.NewBadParameter("x"), flt.OperationFailed, "could not parse")
+
+	// The tower of the fault is searched before its cause.
+	got, ok := faults.AsType[interface{ Unwrap() flt.Fault }](layer{Fault: wrapped})
+	if !ok {
+		t.Fatalf("got no match, want one")
+	}
+
+	if got.(flt.Fault) != wrapped {
+		t.Errorf("got %v, want the wrapping fault", got)
+	}
+}
+
+func TestAs(t *testing.T) {
+	key := faults.NewNoSuchKey("id")
+	bad := faults.NewBadParameter("x must be positive")
+
+	tests := []struct {
+		name  string
+		fault flt.Fault
+		want  flt.Fault
+	}{
+		{name: "nil", fault: nil, want: nil},
+		{name: "itself", fault: key, want: key},
+		{name: "embedded layer", fault: layer{Fault: key}, want: key},
+		{name: "cause", fault: faults.Wrap(key, flt.OperationFailed, "could not load"), want: key},
+		{name: "joined", fault: faults.Join(bad, key), want: key},
+		{name: "cause of a joined fault", fault: faults.Join(bad, faults.Wrap(key, flt.OperationFailed, "w")), want: key},
+		{name: "missing", fault: faults.Join(bad), want: nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got *faults.ErrNoSuchKey
+
+			ok := faults.As(tt.fault, &got)
+
+			if ok != (tt.want != nil) {
+				t.Fatalf("got %t, want %t", ok, tt.want != nil)
+			}
+
+			if ok && flt.Fault(got) != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAsTargets(t *testing.T) {
+	fault := faults.Wrap(faults.NewNoSuchKey("id"), flt.OperationFailed, "could not load")
+
+	var unwrapper interface{ Unwrap() flt.Fault }
+	var err error
+	var str string
+
+	var faulter interface {
+		flt.Fault
+		Unwrap() flt.Fault
+	}
+
+	tests := []struct {
+		name   string
+		target any
+		want   bool
+	}{
+		{name: "nil", target: nil, want: false},
+		{name: "not a pointer", target: str, want: false},
+		{name: "nil pointer", target: (*faults.ErrNoSuchKey)(nil), want: false},
+		{name: "non-fault type", target: &str, want: false},
+		{name: "non-fault interface", target: &unwrapper, want: false},
+		{name: "error", target: &err, want: false},
+		{name: "fault interface", target: &faulter, want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := faults.As(fault, tt.target); got != tt.want {
+				t.Errorf("got %t, want %t", got, tt.want)
+			}
+		})
+	}
+
+	if faulter != fault {
+		t.Errorf("got %v, want the wrapping fault", faulter)
+	}
+
+	if unwrapper != nil || err != nil {
+		t.Errorf("got the rejected targets set to %v and %v, want them left nil", unwrapper, err)
+	}
+}
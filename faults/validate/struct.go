@@ -0,0 +1,489 @@
+package validate
+
+import (
+	"cmp"
+	"fmt"
+	"reflect"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+
+	flt "github.com/PlayerR9/go-fault"
+	"github.com/PlayerR9/go-fault/faults"
+)
+
+const (
+	// TagName is the name of the struct tag read by Struct.
+	TagName string = "fault"
+)
+
+// Validatable is implemented by the types that validate themselves. (See Struct.)
+type Validatable interface {
+	// Validate validates the value.
+	//
+	// Returns:
+	//   - flt.Fault: The fault of the violations, if any.
+	Validate() flt.Fault
+}
+
+var (
+	// validatableType is the reflect type of the Validatable interface.
+	validatableType reflect.Type = reflect.TypeFor[Validatable]()
+
+	// regexps caches the compiled regular expressions of the tags.
+	regexps sync.Map
+)
+
+// rules are the parsed rules of a tag.
+type rules struct {
+	// required tells whether the field must not be the zero value.
+	required bool
+
+	// omitempty tells whether the other rules are skipped when the field is the zero
+	// value.
+	omitempty bool
+
+	// min is the minimum value or length. Nil if unset.
+	min *float64
+
+	// max is the maximum value or length. Nil if unset.
+	max *float64
+
+	// oneof are the allowed values, as printed by fmt.Sprint. Nil if unset.
+	oneof []string
+
+	// regex is the regular expression strings must match. Nil if unset.
+	regex *regexp.Regexp
+}
+
+// parseRules parses the rules of a tag.
+//
+// Parameters:
+//   - tag: The tag. (e.g., "omitempty,min=1,max=64,oneof=a|b,regex=^[a-z]+$")
+//
+// Returns:
+//   - rules: The parsed rules.
+//   - error: An error if the tag is invalid.
+func parseRules(tag string) (rules, error) {
+	var r rules
+
+	for tag != "" {
+		var rule string
+
+		if strings.HasPrefix(tag, "regex=") {
+			// The regular expression may contain commas; as such, it extends to the end of
+			// the tag.
+			rule, tag = tag, ""
+		} else {
+			rule, tag, _ = strings.Cut(tag, ",")
+		}
+
+		name, arg, has_arg := strings.Cut(strings.TrimSpace(rule), "=")
+
+		switch name {
+		case "":
+			// Empty rules are ignored.
+		case "required":
+			r.required = true
+		case "omitempty":
+			r.omitempty = true
+		case "min", "max":
+			if !has_arg {
+				return r, fmt.Errorf("rule %s requires a number", name)
+			}
+
+			n, err := strconv.ParseFloat(arg, 64)
+			if err != nil {
+				return r, fmt.Errorf("rule %s requires a number, got %q", name, arg)
+			}
+
+			if name == "min" {
+				r.min = &n
+			} else {
+				r.max = &n
+			}
+		case "oneof":
+			if !has_arg || arg == "" {
+				return r, fmt.Errorf("rule oneof requires values separated by |")
+			}
+
+			r.oneof = strings.Split(arg, "|")
+		case "regex":
+			re, err := compile(arg)
+			if err != nil {
+				return r, fmt.Errorf("rule regex: %w", err)
+			}
+
+			r.regex = re
+		default:
+			return r, fmt.Errorf("unknown rule (%q)", name)
+		}
+	}
+
+	return r, nil
+}
+
+// compile compiles the regular expression; caching the result.
+//
+// Parameters:
+//   - expr: The regular expression.
+//
+// Returns:
+//   - *regexp.Regexp: The compiled regular expression.
+//   - error: An error if the regular expression is invalid.
+func compile(expr string) (*regexp.Regexp, error) {
+	cached, ok := regexps.Load(expr)
+	if ok {
+		return cached.(*regexp.Regexp), nil
+	}
+
+	re, err := regexp.Compile(expr)
+	if err != nil {
+		return nil, err
+	}
+
+	regexps.Store(expr, re)
+
+	return re, nil
+}
+
+// Struct validates a value according to the "fault" tags of its fields and the Validate
+// methods of its types. (See Validator.Struct.)
+//
+// Parameters:
+//   - value: The value to validate. Usually a struct or a pointer to one.
+//
+// Returns:
+//   - flt.Fault: The faults.JoinFault of the violations, sorted by path. Nil if there are
+//     none.
+//
+// Example:
+//
+//	type User struct {
+//		Name  string   `json:"name" fault:"required,max=64"`
+//		Role  string   `json:"role" fault:"oneof=admin|member"`
+//		Email string   `json:"email" fault:"omitempty,regex=^[^@]+@[^@]+$"`
+//		Tags  []string `json:"tags" fault:"max=8"`
+//	}
+//
+//	err := validate.Struct(user)
+func Struct(value any) flt.Fault {
+	v := New()
+	v.Struct(value)
+
+	return v.Err()
+}
+
+// Struct validates a value, positioned at the current field, according to the "fault"
+// tags of its fields and the Validate methods of its types.
+//
+// The rules of a tag are separated by commas:
+//   - required: the field must not be the zero value. Nil pointers, interfaces, maps,
+//     slices, channels and functions are reported with faults.NewNilParameter.
+//   - omitempty: the other rules are skipped if the field is the zero value.
+//   - min=<n>, max=<n>: the bounds, inclusive, of numbers or of the length of strings,
+//     slices, arrays and maps.
+//   - oneof=<a>|<b>|...: the allowed values, compared with their fmt.Sprint form.
+//   - regex=<expr>: the regular expression strings must match. As it may contain commas,
+//     it must be the last rule.
+//
+// Unless omitempty is given, the rules also apply to zero values; so that a field tagged
+// "min=1" rejects 0. They are not applied to nil pointers and interfaces, which have no
+// value to check, nor to the fields reported as required.
+//
+// Fields are named after their "json" tag if any, and the tag "-" skips a field. Nested structs, pointers,
+// slices, arrays and maps are validated recursively; except the nested values that
+// implement Validatable, whose Validate methods are called instead and whose violations
+// are merged at their paths. (See Merge.) The Validate method of the value itself is not
+// called; so that it can check the tags with Struct:
+//
+//	func (a Address) Validate() flt.Fault {
+//		return validate.Struct(a)
+//	}
+//
+// Parameters:
+//   - value: The value to validate.
+func (v *Validator) Struct(value any) {
+	if value == nil {
+		v.Report("must be non-nil")
+
+		return
+	}
+
+	w := walker{
+		seen: make(map[seenKey]struct{}),
+	}
+
+	// The Validate method of the value itself is not called; so that it can be
+	// implemented with Struct.
+	w.walk(v, reflect.ValueOf(value), false)
+}
+
+// seenKey identifies a pointer that was already walked.
+type seenKey struct {
+	// ptr is the address.
+	ptr uintptr
+
+	// typ is the type of the pointed value.
+	typ reflect.Type
+}
+
+// walker walks a value to validate it.
+type walker struct {
+	// seen are the pointers that were already walked; so that cyclic values terminate.
+	seen map[seenKey]struct{}
+}
+
+// walk validates the value and its nested values.
+//
+// Parameters:
+//   - v: The validator positioned at the value.
+//   - rv: The value.
+//   - call: Whether the Validate method of the value is called.
+func (w walker) walk(v *Validator, rv reflect.Value, call bool) {
+	if !rv.IsValid() {
+		return
+	}
+
+	if call && w.callValidate(v, rv) {
+		return
+	}
+
+	switch rv.Kind() {
+	case reflect.Pointer:
+		if rv.IsNil() {
+			return
+		}
+
+		key := seenKey{ptr: rv.Pointer(), typ: rv.Type()}
+
+		_, ok := w.seen[key]
+		if ok {
+			return
+		}
+
+		w.seen[key] = struct{}{}
+
+		elem := rv.Elem()
+
+		// The method set of the pointer includes the one of the pointed value; as such,
+		// its Validate method, if any, was already considered.
+		w.walk(v, elem, false)
+	case reflect.Interface:
+		if !rv.IsNil() {
+			w.walk(v, rv.Elem(), call)
+		}
+	case reflect.Struct:
+		w.walkFields(v, rv)
+	case reflect.Slice, reflect.Array:
+		for i := range rv.Len() {
+			w.walk(v.Index(i), rv.Index(i), true)
+		}
+	case reflect.Map:
+		keys := rv.MapKeys()
+
+		slices.SortFunc(keys, func(a, b reflect.Value) int {
+			return cmp.Compare(fmt.Sprint(a.Interface()), fmt.Sprint(b.Interface()))
+		})
+
+		for _, key := range keys {
+			w.walk(v.Field(fmt.Sprint(key.Interface())), rv.MapIndex(key), true)
+		}
+	}
+}
+
+// callValidate reports the faults of the Validate method of the value, if it (or, when it
+// is addressable, its address) implements Validatable. (See Validator.Merge.)
+//
+// Parameters:
+//   - v: The validator positioned at the value.
+//   - rv: The value.
+//
+// Returns:
+//   - bool: True if the method was called, false otherwise.
+func (w walker) callValidate(v *Validator, rv reflect.Value) bool {
+	if !rv.Type().Implements(validatableType) {
+		if !rv.CanAddr() || !rv.Addr().Type().Implements(validatableType) {
+			return false
+		}
+
+		// The method is declared on the pointer receiver.
+		rv = rv.Addr()
+	}
+
+	if !rv.CanInterface() {
+		return false
+	}
+
+	switch rv.Kind() {
+	case reflect.Pointer, reflect.Interface, reflect.Map, reflect.Slice:
+		if rv.IsNil() {
+			return false
+		}
+	}
+
+	v.Merge(rv.Interface().(Validatable).Validate())
+
+	return true
+}
+
+// walkFields validates the exported fields of the struct.
+//
+// Parameters:
+//   - v: The validator positioned at the struct.
+//   - rv: The struct.
+func (w walker) walkFields(v *Validator, rv reflect.Value) {
+	typ := rv.Type()
+
+	for i := range typ.NumField() {
+		field := typ.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+
+		tag := field.Tag.Get(TagName)
+		if tag == "-" {
+			continue
+		}
+
+		fv := v.Field(fieldName(field))
+		value := rv.Field(i)
+
+		if tag != "" {
+			r, err := parseRules(tag)
+			if err != nil {
+				fv.Report("has an invalid " + TagName + " tag: " + err.Error())
+
+				continue
+			}
+
+			if !r.check(fv, value) {
+				continue
+			}
+		}
+
+		w.walk(fv, value, true)
+	}
+}
+
+// fieldName returns the name of the field in the paths; its "json" name if any.
+//
+// Parameters:
+//   - field: The field.
+//
+// Returns:
+//   - string: The name of the field.
+func fieldName(field reflect.StructField) string {
+	name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+	if name != "" && name != "-" {
+		return name
+	}
+
+	return field.Name
+}
+
+// check checks the value against the rules.
+//
+// Parameters:
+//   - v: The validator positioned at the value.
+//   - rv: The value.
+//
+// Returns:
+//   - bool: False if the value is zero; in which case its nested values are not walked.
+func (r rules) check(v *Validator, rv reflect.Value) bool {
+	zero := rv.IsZero()
+
+	if zero && r.required {
+		switch rv.Kind() {
+		case reflect.Pointer, reflect.Interface, reflect.Map, reflect.Slice, reflect.Chan, reflect.Func:
+			v.Add(faults.NewNilParameter(v.path.String()))
+		default:
+			v.Report("is required")
+		}
+
+		return false
+	}
+
+	if zero && r.omitempty {
+		return false
+	}
+
+	for rv.Kind() == reflect.Pointer || rv.Kind() == reflect.Interface {
+		if rv.IsNil() {
+			return false
+		}
+
+		rv = rv.Elem()
+	}
+
+	r.apply(v, rv)
+
+	return !zero
+}
+
+// apply checks the value against the min, max, oneof and regex rules.
+//
+// Parameters:
+//   - v: The validator positioned at the value.
+//   - rv: The value; neither a pointer nor an interface.
+func (r rules) apply(v *Validator, rv reflect.Value) {
+	if r.min != nil || r.max != nil {
+		n, is_length, measurable := measure(rv)
+		if measurable {
+			what := "must be"
+			if is_length {
+				what = "length must be"
+			}
+
+			if r.min != nil && n < *r.min {
+				v.Report(fmt.Sprintf("%s at least %v", what, *r.min))
+			}
+
+			if r.max != nil && n > *r.max {
+				v.Report(fmt.Sprintf("%s at most %v", what, *r.max))
+			}
+		}
+	}
+
+	if r.oneof != nil {
+		str := fmt.Sprint(rv.Interface())
+
+		if !slices.Contains(r.oneof, str) {
+			v.Report(fmt.Sprintf("must be one of %s", strings.Join(r.oneof, ", ")))
+		}
+	}
+
+	if r.regex != nil && rv.Kind() == reflect.String {
+		if !r.regex.MatchString(rv.String()) {
+			v.Report(fmt.Sprintf("must match %s", r.regex.String()))
+		}
+	}
+}
+
+// measure returns the number min and max are compared with.
+//
+// Parameters:
+//   - rv: The value.
+//
+// Returns:
+//   - float64: The number.
+//   - bool: True if the number is a length, false if it is the value itself.
+//   - bool: False if the value can be neither measured nor compared.
+func measure(rv reflect.Value) (float64, bool, bool) {
+	switch rv.Kind() {
+	case reflect.String:
+		return float64(len([]rune(rv.String()))), true, true
+	case reflect.Slice, reflect.Array, reflect.Map, reflect.Chan:
+		return float64(rv.Len()), true, true
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(rv.Int()), false, true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return float64(rv.Uint()), false, true
+	case reflect.Float32, reflect.Float64:
+		return rv.Float(), false, true
+	default:
+		return 0, false, false
+	}
+}
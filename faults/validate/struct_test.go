@@ -0,0 +1,250 @@
+package validate_test
+
+import (
+	"slices"
+	"testing"
+
+	flt "github.com/PlayerR9/go-fault"
+	"github.com/PlayerR9/go-fault/faults"
+	"github.com/PlayerR9/go-fault/faults/validate"
+)
+
+type address struct {
+	Zip string `json:"zip" fault:"required,regex=^[0-9]{5}$"`
+}
+
+func (a address) Validate() flt.Fault {
+	return validate.Struct(a)
+}
+
+type user struct {
+	Name      string            `json:"name" fault:"required,max=8"`
+	Role      string            `json:"role" fault:"omitempty,oneof=admin|member"`
+	Age       int               `json:"age" fault:"omitempty,min=18"`
+	Manager   *user             `json:"manager,omitempty"`
+	Owner     *user             `json:"owner" fault:"required"`
+	Addresses []address         `json:"addresses"`
+	Labels    map[string]string `json:"labels" fault:"max=1"`
+	Secret    string            `json:"-" fault:"-"`
+}
+
+type server struct {
+	Port    int               `json:"port" fault:"min=1,max=65535"`
+	Host    string            `json:"host" fault:"regex=^[a-z.]+$"`
+	Mode    string            `json:"mode" fault:"oneof=tcp|udp"`
+	Proxy   string            `json:"proxy" fault:"omitempty,regex=^[a-z.]+$"`
+	Backlog int               `json:"backlog" fault:"omitempty,min=16"`
+	Peers   []string          `json:"peers" fault:"min=1"`
+	Config  *address          `json:"config" fault:"required"`
+	Env     map[string]string `json:"env" fault:"required"`
+	Next    *server           `json:"next" fault:"min=1"`
+}
+
+type badTag struct {
+	N int `fault:"min=x"`
+}
+
+type checked struct {
+	Value int
+}
+
+func (c *checked) Validate() flt.Fault {
+	v := validate.New()
+	v.Field("value").Require(c.Value > 0, "must be positive")
+
+	return v.Err()
+}
+
+type holder struct {
+	Items []checked `json:"items"`
+}
+
+// messagesOf returns the messages of the violations of a fault. The messages of the
+// violations that are not *faults.ErrInvalidField are prefixed with their path.
+func messagesOf(tb testing.TB, fault flt.Fault) []string {
+	tb.Helper()
+
+	if fault == nil {
+		return nil
+	}
+
+	var msgs []string
+
+	for _, elem := range fault.(*faults.JoinFault).Faults() {
+		_, ok := faults.Access[*faults.ErrInvalidField](elem)
+		if ok {
+			msgs = append(msgs, faults.MessageOf(elem))
+
+			continue
+		}
+
+		path, _ := faults.Get(elem, faults.PathKey)
+		msgs = append(msgs, path+": "+faults.MessageOf(elem))
+	}
+
+	return msgs
+}
+
+func TestStruct(t *testing.T) {
+	owner := &user{Name: "root", Owner: &user{Name: "x"}}
+	owner.Owner.Owner = owner
+
+	tests := []struct {
+		name  string
+		value any
+		want  []string
+	}{
+		{
+			name:  "valid",
+			value: user{Name: "ann", Role: "admin", Age: 20, Owner: owner},
+			want:  nil,
+		},
+		{
+			name:  "nil",
+			value: nil,
+			want:  []string{"$: must be non-nil"},
+		},
+		{
+			name:  "rules",
+			value: user{Name: "too long a name", Role: "guest", Age: 3, Labels: map[string]string{"b": "", "a": ""}},
+			want: []string{
+				"age: must be at least 18",
+				"labels: length must be at most 1",
+				"name: length must be at most 8",
+				`owner: parameter ("owner") must be non-nil`,
+				"role: must be one of admin, member",
+			},
+		},
+		{
+			name:  "zero values",
+			value: server{},
+			want: []string{
+				`config: parameter ("config") must be non-nil`,
+				`env: parameter ("env") must be non-nil`,
+				"host: must match ^[a-z.]+$",
+				"mode: must be one of tcp, udp",
+				"peers: length must be at least 1",
+				"port: must be at least 1",
+			},
+		},
+		{
+			name:  "omitempty",
+			value: server{Port: 80, Host: "localhost", Mode: "tcp", Proxy: "Proxy!", Backlog: 8, Peers: []string{"a"}, Config: &address{Zip: "12345"}, Env: map[string]string{}},
+			want: []string{
+				"backlog: must be at least 16",
+				"proxy: must match ^[a-z.]+$",
+			},
+		},
+		{
+			name:  "nested Validate",
+			value: &user{Name: "ann", Owner: owner, Addresses: []address{{Zip: "12345"}, {Zip: "1"}}},
+			want:  []string{"addresses[1].zip: must match ^[0-9]{5}$"},
+		},
+		{
+			name:  "root Validate",
+			value: address{Zip: "x"},
+			want:  []string{"zip: must match ^[0-9]{5}$"},
+		},
+		{
+			name:  "pointer receiver",
+			value: holder{Items: []checked{{Value: 1}, {Value: 0}}},
+			want:  []string{"items[1].value: must be positive"},
+		},
+		{
+			name:  "invalid tag",
+			value: badTag{},
+			want:  []string{`N: has an invalid fault tag: rule min requires a number, got "x"`},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := messagesOf(t, validate.Struct(tt.value))
+
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("got %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMergeRebasesPaths(t *testing.T) {
+	v := validate.New()
+	v.Field("addresses").Index(0).Merge(address{Zip: "1"}.Validate())
+
+	violations := v.Violations()
+	if len(violations) != 1 {
+		t.Fatalf("got %d violations, want 1", len(violations))
+	}
+
+	path, _ := faults.Get(violations[0], faults.PathKey)
+	if path != "addresses[0].zip" {
+		t.Errorf("got path %q, want %q", path, "addresses[0].zip")
+	}
+}
+
+func TestMergeTwice(t *testing.T) {
+	nested := address{Zip: "1"}.Validate()
+
+	v := validate.New()
+	v.Field("home").Merge(nested)
+	v.Field("work").Merge(nested)
+
+	got := messagesOf(t, v.Err())
+	want := []string{"home.zip: must match ^[0-9]{5}$", "work.zip: must match ^[0-9]{5}$"}
+
+	if !slices.Equal(got, want) {
+		t.Errorf("got %q, want %q", got, want)
+	}
+
+	// The merged fault is left untouched.
+	if got := messagesOf(t, nested); !slices.Equal(got, []string{"zip: must match ^[0-9]{5}$"}) {
+		t.Errorf("got the nested fault changed to %q", got)
+	}
+}
+
+func TestMergeOtherFaults(t *testing.T) {
+	tests := []struct {
+		name string
+		path string
+		want string
+	}{
+		{name: "field", path: "zip", want: "addresses.zip"},
+		{name: "index", path: "[1]", want: "addresses[1]"},
+		{name: "index and field", path: "[1].zip", want: "addresses[1].zip"},
+		{name: "nested indices", path: "[1][0].zip", want: "addresses[1][0].zip"},
+		{name: "root", path: "$", want: "addresses"},
+		{name: "malformed index", path: "[x].zip", want: "addresses.[x].zip"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fault := faults.NewNotFound("no such address")
+			_ = faults.Set(fault, faults.PathKey, tt.path)
+
+			v := validate.New()
+			v.Field("addresses").Merge(fault)
+
+			path, _ := faults.Get(fault, faults.PathKey)
+			if path != tt.want {
+				t.Errorf("got path %q, want %q", path, tt.want)
+			}
+		})
+	}
+}
+
+func TestMergeSortsByRebasedPath(t *testing.T) {
+	fault := faults.NewNotFound("no such address")
+	_ = faults.Set(fault, faults.PathKey, "[1]")
+
+	v := validate.New()
+	v.Field("addresses").Merge(fault)
+	v.Field("addresses").Index(0).Report("must be non-empty")
+
+	got := messagesOf(t, v.Err())
+	want := []string{"addresses[0]: must be non-empty", "addresses[1]: no such address"}
+
+	if !slices.Equal(got, want) {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
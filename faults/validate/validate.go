@@ -0,0 +1,423 @@
+// Package validate validates structured values, such as requests or configurations, and
+// reports all the violations at once; each with the path of the offending field.
+//
+// Example:
+//
+//	func (u User) Validate() flt.Fault {
+//		v := validate.New()
+//
+//		v.Field("name").Require(u.Name != "", "must be non-empty")
+//
+//		for i, addr := range u.Addresses {
+//			a := v.Field("addresses").Index(i)
+//
+//			a.Field("zip").Require(len(addr.Zip) == 5, "must be 5 digits long")
+//		}
+//
+//		return v.Err()
+//	}
+package validate
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+
+	flt "github.com/PlayerR9/go-fault"
+	"github.com/PlayerR9/go-fault/faults"
+)
+
+// collector collects the violations of a validation. It is shared by all the validators
+// derived from the same root.
+type collector struct {
+	// mu guards violations.
+	mu sync.Mutex
+
+	// violations are the violations, in order of report.
+	violations []violation
+}
+
+// violation is a reported violation.
+type violation struct {
+	// path is the path of the field.
+	path faults.FieldPath
+
+	// fault is the fault of the violation.
+	fault flt.Fault
+}
+
+// Validator reports the violations of the field it is positioned at. The validators
+// returned by Field and Index report to the same collection as their parent; which
+// allows them to be passed down to the validation of nested values.
+type Validator struct {
+	// path is the path of the field.
+	path faults.FieldPath
+
+	// collector collects the violations.
+	collector *collector
+}
+
+// New creates a new Validator positioned at the root of the value.
+//
+// Returns:
+//   - *Validator: The new Validator. Never returns nil.
+func New() *Validator {
+	return &Validator{
+		collector: &collector{},
+	}
+}
+
+// Field returns a validator positioned at a field of the current field.
+//
+// Parameters:
+//   - name: The name of the field.
+//
+// Returns:
+//   - *Validator: The new Validator. Never returns nil.
+func (v *Validator) Field(name string) *Validator {
+	return &Validator{
+		path:      v.path.Field(name),
+		collector: v.collector,
+	}
+}
+
+// Index returns a validator positioned at an element of the current field.
+//
+// Parameters:
+//   - index: The index of the element.
+//
+// Returns:
+//   - *Validator: The new Validator. Never returns nil.
+func (v *Validator) Index(index int) *Validator {
+	return &Validator{
+		path:      v.path.Index(index),
+		collector: v.collector,
+	}
+}
+
+// Path returns the path of the field the validator is positioned at.
+//
+// Returns:
+//   - faults.FieldPath: The path.
+func (v *Validator) Path() faults.FieldPath {
+	return v.path
+}
+
+// Require reports a violation of the current field unless ok is true.
+//
+// Parameters:
+//   - ok: Whether the requirement is met.
+//   - msg: The message of the violation. (e.g., "must be non-empty")
+//
+// Returns:
+//   - bool: ok; so that dependent checks can be skipped.
+//
+// Example:
+//
+//	if v.Field("port").Require(cfg.Port > 0, "must be positive") {
+//		// ...
+//	}
+func (v *Validator) Require(ok bool, msg string) bool {
+	if !ok {
+		v.Report(msg)
+	}
+
+	return ok
+}
+
+// Requiref is like Require but the message is formatted with fmt.Sprintf.
+//
+// Parameters:
+//   - ok: Whether the requirement is met.
+//   - format: The format of the message.
+//   - args: The arguments of the format.
+//
+// Returns:
+//   - bool: ok; so that dependent checks can be skipped.
+func (v *Validator) Requiref(ok bool, format string, args ...any) bool {
+	if !ok {
+		v.Report(fmt.Sprintf(format, args...))
+	}
+
+	return ok
+}
+
+// Report reports a violation of the current field.
+//
+// Parameters:
+//   - msg: The message of the violation.
+//   - opts: The options of the fault of the violation.
+func (v *Validator) Report(msg string, opts ...faults.FaultOption) {
+	v.Add(faults.NewInvalidField(v.path, msg, opts...))
+}
+
+// Add reports a fault as a violation of the current field. It allows to report faults
+// other than the ones of Report, such as the ones of faults.NewNilParameter. The faults of
+// the Validate methods of nested values are better reported with Merge.
+//
+// Unless it already has one, the faults.PathKey of the fault is set to the path of the
+// current field.
+//
+// Parameters:
+//   - fault: The fault to report. Does nothing if nil.
+func (v *Validator) Add(fault flt.Fault) {
+	if fault == nil {
+		return
+	}
+
+	if !faults.Has(fault, faults.PathKey) {
+		_ = faults.Set(fault, faults.PathKey, v.path.String())
+	}
+
+	v.add(v.path, fault)
+}
+
+// add adds a violation to the collection.
+//
+// Parameters:
+//   - path: The path of the violation.
+//   - fault: The fault of the violation.
+func (v *Validator) add(path faults.FieldPath, fault flt.Fault) {
+	c := v.collector
+
+	c.mu.Lock()
+	c.violations = append(c.violations, violation{
+		path:  path,
+		fault: fault,
+	})
+	c.mu.Unlock()
+}
+
+// Merge reports the violations of a nested value, such as the fault returned by its
+// Validate method, as violations of the current field. Joined faults are merged one by
+// one and the paths of the violations, relative to the nested value, are rebased onto
+// the path of the current field.
+//
+// The *faults.ErrInvalidField violations are rebased on a copy; so that the fault of the
+// nested value is left untouched and can be merged more than once. The other faults, such
+// as the ones embedding an *faults.ErrInvalidField, cannot be copied: their path, if any,
+// is rebased in place.
+//
+// Parameters:
+//   - fault: The fault to merge. Does nothing if nil.
+//
+// Example:
+//
+//	for i, addr := range u.Addresses {
+//		v.Field("addresses").Index(i).Merge(addr.Validate())
+//	}
+func (v *Validator) Merge(fault flt.Fault) {
+	if fault == nil {
+		return
+	}
+
+	jf, ok := fault.(*faults.JoinFault)
+	if ok {
+		for _, inner := range jf.Faults() {
+			v.Merge(inner)
+		}
+
+		return
+	}
+
+	ief, ok := fault.(*faults.ErrInvalidField)
+	if ok {
+		rebased := rebase(ief, v.path.Join(ief.Path))
+
+		v.add(rebased.Path, rebased)
+
+		return
+	}
+
+	ief, ok = faults.Access[*faults.ErrInvalidField](fault)
+	if ok {
+		ief.Path = v.path.Join(ief.Path)
+		_ = faults.Set(fault, faults.PathKey, ief.Path.String())
+
+		v.add(ief.Path, fault)
+
+		return
+	}
+
+	str, ok := faults.Get(fault, faults.PathKey)
+	if !ok {
+		v.Add(fault)
+
+		return
+	}
+
+	path := v.path.Join(parsePath(str))
+	_ = faults.Set(fault, faults.PathKey, path.String())
+
+	v.add(path, fault)
+}
+
+// rebase returns a copy of the violation positioned at the given path. The copy has its
+// own context; as such, setting its path leaves the violation untouched.
+//
+// Parameters:
+//   - ief: The violation. Assumed to be non-nil.
+//   - path: The path of the copy.
+//
+// Returns:
+//   - *faults.ErrInvalidField: The copy. Never returns nil.
+func rebase(ief *faults.ErrInvalidField, path faults.FieldPath) *faults.ErrInvalidField {
+	base, ok := faults.Access[*flt.BaseFault](ief.Fault)
+	if !ok {
+		panic(flt.BadConstruction.Init())
+	}
+
+	cp := flt.Restore(base.Descriptor(), base.Timestamp())
+	_ = cp.AddSuggestions(base.Suggestions()...)
+
+	for _, frame := range base.StackTrace() {
+		_ = cp.AppendFrame(frame)
+	}
+
+	for _, key := range base.Keys() {
+		value, _ := base.Value(key)
+		_ = cp.SetKey(key, value)
+
+		mode, ok := base.Sensitivity(key)
+		if ok {
+			_ = cp.MarkSensitive(key, mode)
+		}
+	}
+
+	fault := &faults.ErrInvalidField{
+		Fault: cp,
+		Path:  path,
+	}
+
+	_ = faults.Set(fault, faults.PathKey, path.String())
+
+	return fault
+}
+
+// parsePath parses a path rendered by faults.FieldPath.String. The parts that are not
+// well-formed are kept as field names; so that the path renders as the given string.
+//
+// Parameters:
+//   - str: The rendered path. (e.g., "zip" or "[2].zip") Both "$" and "" are the root.
+//
+// Returns:
+//   - faults.FieldPath: The parsed path.
+func parsePath(str string) faults.FieldPath {
+	var path faults.FieldPath
+
+	if str == "$" || str == "" {
+		return path
+	}
+
+	for _, part := range strings.Split(str, ".") {
+		name, rest, _ := strings.Cut(part, "[")
+		if rest != "" {
+			rest = "[" + rest
+		}
+
+		indices, ok := parseIndices(rest)
+		if !ok {
+			path = path.Field(part)
+
+			continue
+		}
+
+		if name != "" || rest == "" {
+			path = path.Field(name)
+		}
+
+		for _, index := range indices {
+			path = path.Index(index)
+		}
+	}
+
+	return path
+}
+
+// parseIndices parses a run of indices. (e.g., "[2][0]")
+//
+// Parameters:
+//   - str: The run of indices.
+//
+// Returns:
+//   - []int: The indices.
+//   - bool: True if the run is well-formed, false otherwise.
+func parseIndices(str string) ([]int, bool) {
+	var indices []int
+
+	for str != "" {
+		if str[0] != '[' {
+			return nil, false
+		}
+
+		end := strings.IndexByte(str, ']')
+		if end < 0 {
+			return nil, false
+		}
+
+		index, err := strconv.Atoi(str[1:end])
+		if err != nil {
+			return nil, false
+		}
+
+		indices = append(indices, index)
+		str = str[end+1:]
+	}
+
+	return indices, true
+}
+
+// Len returns the number of violations reported so far; by this validator and all the
+// validators that share its collection.
+//
+// Returns:
+//   - int: The number of violations.
+func (v *Validator) Len() int {
+	c := v.collector
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return len(c.violations)
+}
+
+// Violations returns the violations reported so far, sorted by path. Violations of the
+// same field are kept in order of report.
+//
+// Returns:
+//   - []flt.Fault: The faults of the violations. Unless added with Add, their dynamic
+//     type is *faults.ErrInvalidField.
+func (v *Validator) Violations() []flt.Fault {
+	c := v.collector
+
+	c.mu.Lock()
+	violations := slices.Clone(c.violations)
+	c.mu.Unlock()
+
+	slices.SortStableFunc(violations, func(a, b violation) int {
+		return a.path.Compare(b.path)
+	})
+
+	elems := make([]flt.Fault, 0, len(violations))
+
+	for _, violation := range violations {
+		elems = append(elems, violation.fault)
+	}
+
+	return elems
+}
+
+// Err returns the violations reported so far, sorted by path, as a single fault.
+//
+// Returns:
+//   - flt.Fault: The faults.JoinFault of the violations. Nil if there are none.
+func (v *Validator) Err() flt.Fault {
+	violations := v.Violations()
+	if len(violations) == 0 {
+		return nil
+	}
+
+	return faults.Join(violations...)
+}
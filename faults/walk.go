@@ -0,0 +1,461 @@
+package faults
+
+import (
+	"iter"
+	"reflect"
+	"strconv"
+	"strings"
+
+	flt "github.com/PlayerR9/go-fault"
+)
+
+// Relation is the relationship between a fault and a fault it leads to. Relations can be
+// combined with the | operator.
+type Relation int
+
+const (
+	// EmbedRelation leads to the fault that a fault embeds. (See flt.Fault.Embeds.)
+	EmbedRelation Relation = 1 << iota
+
+	// CauseRelation leads to the cause of a fault. (See Wrap.)
+	CauseRelation
+
+	// JoinRelation leads to the faults that a fault joins. (See Join.)
+	JoinRelation
+
+	// AllRelations is the combination of all the relations.
+	AllRelations Relation = EmbedRelation | CauseRelation | JoinRelation
+)
+
+// String implements the fmt.Stringer interface.
+//
+// Format:
+//
+//	"<relation>|<relation>|..."
+//
+// Where, each <relation> is one of "embed", "cause" and "join".
+func (r Relation) String() string {
+	var names []string
+
+	if r&EmbedRelation != 0 {
+		names = append(names, "embed")
+	}
+
+	if r&CauseRelation != 0 {
+		names = append(names, "cause")
+	}
+
+	if r&JoinRelation != 0 {
+		names = append(names, "join")
+	}
+
+	rest := r &^ AllRelations
+	if rest != 0 || len(names) == 0 {
+		names = append(names, "Relation("+strconv.Itoa(int(rest))+")")
+	}
+
+	return strings.Join(names, "|")
+}
+
+// Step is a step of a Path.
+type Step struct {
+	// Relation is the relationship followed by the step. It is a single relation.
+	Relation Relation
+
+	// Index is the index of the joined fault. Only meaningful for JoinRelation.
+	Index int
+}
+
+// String implements the fmt.Stringer interface.
+//
+// Format:
+//
+//	"embed", "cause" or "join[<index>]"
+func (s Step) String() string {
+	if s.Relation == JoinRelation {
+		return "join[" + strconv.Itoa(s.Index) + "]"
+	}
+
+	return s.Relation.String()
+}
+
+// Path is the path from the fault at which a walk starts to a fault it reaches. (e.g.,
+// cause.join[1].embed) It is immutable: its methods return new paths.
+type Path struct {
+	// steps are the steps of the path, from the starting fault.
+	steps []Step
+}
+
+// with returns a copy of the path with the step appended.
+//
+// Parameters:
+//   - step: The step to append.
+//
+// Returns:
+//   - Path: The new path.
+func (p Path) with(step Step) Path {
+	steps := make([]Step, len(p.steps), len(p.steps)+1)
+	copy(steps, p.steps)
+
+	return Path{
+		steps: append(steps, step),
+	}
+}
+
+// Steps returns the steps of the path.
+//
+// Returns:
+//   - []Step: A copy of the steps, from the starting fault.
+func (p Path) Steps() []Step {
+	return append([]Step(nil), p.steps...)
+}
+
+// Depth returns the number of steps of the path.
+//
+// Returns:
+//   - int: The depth. 0 for the starting fault.
+func (p Path) Depth() int {
+	return len(p.steps)
+}
+
+// IsRoot checks whether the path leads to the starting fault.
+//
+// Returns:
+//   - bool: True if the path has no step, false otherwise.
+func (p Path) IsRoot() bool {
+	return len(p.steps) == 0
+}
+
+// Last returns the last step of the path.
+//
+// Returns:
+//   - Step: The last step.
+//   - bool: False if the path has no step, true otherwise.
+func (p Path) Last() (Step, bool) {
+	if len(p.steps) == 0 {
+		return Step{}, false
+	}
+
+	return p.steps[len(p.steps)-1], true
+}
+
+// String implements the fmt.Stringer interface.
+//
+// Format:
+//
+//	"<step>.<step>..."
+//
+// The path of the starting fault is rendered as "$".
+func (p Path) String() string {
+	if len(p.steps) == 0 {
+		return "$"
+	}
+
+	parts := make([]string, 0, len(p.steps))
+
+	for _, step := range p.steps {
+		parts = append(parts, step.String())
+	}
+
+	return strings.Join(parts, ".")
+}
+
+// walkConfig is the configuration of Walk.
+type walkConfig struct {
+	// breadth_first tells whether the faults are visited in breadth-first order.
+	breadth_first bool
+
+	// relations are the relations that are followed.
+	relations Relation
+}
+
+// WalkOption is an option of Walk.
+type WalkOption func(cfg *walkConfig)
+
+// BreadthFirst returns an option that makes the walk visit the faults in breadth-first
+// order; rather than in depth-first (pre-)order.
+//
+// Returns:
+//   - WalkOption: The option. Never returns nil.
+func BreadthFirst() WalkOption {
+	return func(cfg *walkConfig) {
+		cfg.breadth_first = true
+	}
+}
+
+// Following returns an option that restricts the relations followed by the walk.
+//
+// When EmbedRelation is not followed, the causes and the joined faults of a fault are
+// looked for in its whole embedding tower; so that a fault that embeds a WrapFault still
+// leads to its cause.
+//
+// Parameters:
+//   - relations: The relations to follow. (e.g., CauseRelation | JoinRelation)
+//
+// Returns:
+//   - WalkOption: The option. Never returns nil.
+func Following(relations Relation) WalkOption {
+	return func(cfg *walkConfig) {
+		cfg.relations = relations
+	}
+}
+
+// node is a fault reached by a walk.
+type node struct {
+	// path is the path to the fault.
+	path Path
+
+	// fault is the fault.
+	fault flt.Fault
+}
+
+// Walk returns an iterator over a fault and the faults it leads to; along with their
+// paths.
+//
+// By default, all the relations are followed in depth-first order; the embedded fault
+// first, then the cause, then the joined faults in order. Each fault is visited once, even
+// if several paths lead to it; which also breaks the cycles. Faults are identified by
+// their addresses or, for faults that are values, by their types and contents; so that
+// non-comparable faults are supported.
+//
+// Parameters:
+//   - fault: The fault to start from.
+//   - opts: The options of the walk.
+//
+// Returns:
+//   - iter.Seq2[Path, flt.Fault]: The iterator. Empty if the fault is nil.
+func Walk(fault flt.Fault, opts ...WalkOption) iter.Seq2[Path, flt.Fault] {
+	cfg := walkConfig{
+		relations: AllRelations,
+	}
+
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	return func(yield func(Path, flt.Fault) bool) {
+		if fault == nil {
+			return
+		}
+
+		seen := map[any]struct{}{
+			keyOf(fault): {},
+		}
+
+		pending := []node{{fault: fault}}
+
+		for len(pending) > 0 {
+			var current node
+
+			if cfg.breadth_first {
+				current = pending[0]
+				pending = pending[1:]
+			} else {
+				current = pending[len(pending)-1]
+				pending = pending[:len(pending)-1]
+			}
+
+			if !yield(current.path, current.fault) {
+				return
+			}
+
+			children := childrenOf(current, cfg.relations)
+
+			var fresh []node
+
+			for _, child := range children {
+				id := keyOf(child.fault)
+
+				_, ok := seen[id]
+				if ok {
+					continue
+				}
+
+				seen[id] = struct{}{}
+				fresh = append(fresh, child)
+			}
+
+			if !cfg.breadth_first {
+				for i, j := 0, len(fresh)-1; i < j; i, j = i+1, j-1 {
+					fresh[i], fresh[j] = fresh[j], fresh[i]
+				}
+			}
+
+			pending = append(pending, fresh...)
+		}
+	}
+}
+
+// All is like Walk but without the paths.
+//
+// Parameters:
+//   - fault: The fault to start from.
+//   - opts: The options of the walk.
+//
+// Returns:
+//   - iter.Seq[flt.Fault]: The iterator. Empty if the fault is nil.
+func All(fault flt.Fault, opts ...WalkOption) iter.Seq[flt.Fault] {
+	return func(yield func(flt.Fault) bool) {
+		for _, f := range Walk(fault, opts...) {
+			if !yield(f) {
+				return
+			}
+		}
+	}
+}
+
+// childrenOf returns the faults that a reached fault leads to, in order.
+//
+// Parameters:
+//   - parent: The reached fault.
+//   - relations: The relations to follow.
+//
+// Returns:
+//   - []node: The faults it leads to. Never contains nil faults.
+func childrenOf(parent node, relations Relation) []node {
+	var children []node
+
+	add := func(step Step, fault flt.Fault) {
+		if fault != nil {
+			children = append(children, node{
+				path:  parent.path.with(step),
+				fault: fault,
+			})
+		}
+	}
+
+	if relations&EmbedRelation != 0 {
+		add(Step{Relation: EmbedRelation}, parent.fault.Embeds())
+	}
+
+	if relations&(CauseRelation|JoinRelation) == 0 {
+		return children
+	}
+
+	elem := parent.fault
+
+	for elem != nil {
+		switch elem := elem.(type) {
+		case interface{ Unwrap() flt.Fault }:
+			if relations&CauseRelation != 0 {
+				add(Step{Relation: CauseRelation}, elem.Unwrap())
+			}
+
+			return children
+		case interface{ Unwrap() []flt.Fault }:
+			if relations&JoinRelation != 0 {
+				for i, inner := range elem.Unwrap() {
+					add(Step{Relation: JoinRelation, Index: i}, inner)
+				}
+			}
+
+			return children
+		}
+
+		if relations&EmbedRelation != 0 {
+			break
+		}
+
+		elem = elem.Embeds()
+	}
+
+	return children
+}
+
+// keyOf returns the key under which a walk records that a fault was visited. The faults
+// built by this package are their own keys; which spares the reflection of identityOf.
+//
+// Parameters:
+//   - fault: The fault.
+//
+// Returns:
+//   - any: The key. Either a comparable fault or the result of identityOf.
+func keyOf(fault flt.Fault) any {
+	switch fault.(type) {
+	case *flt.BaseFault, *WrapFault, *JoinFault, *ErrFault, *ErrPanic, *RecordedFault,
+		*ErrNoSuchKey, *ErrOutOfRange, *ErrTypeMismatch, *ErrNilParameter, *ErrTimeout,
+		*ErrInvalidField, *ErrQuerySyntax:
+		return fault
+	default:
+		return identityOf(fault)
+	}
+}
+
+// identityOf returns a key that identifies a fault. Faults that are pointers (or other
+// reference kinds) are identified by their addresses, the others by their types and
+// contents; where the references they hold are identified by their addresses.
+//
+// Parameters:
+//   - fault: The fault to identify.
+//
+// Returns:
+//   - string: The key.
+func identityOf(fault flt.Fault) string {
+	var builder strings.Builder
+
+	writeIdentity(&builder, reflect.ValueOf(fault))
+
+	return builder.String()
+}
+
+// writeIdentity writes the key that identifies a value. (See identityOf.)
+//
+// Parameters:
+//   - builder: The builder to write to.
+//   - v: The value to identify.
+func writeIdentity(builder *strings.Builder, v reflect.Value) {
+	if !v.IsValid() {
+		builder.WriteString("nil")
+
+		return
+	}
+
+	builder.WriteString(v.Type().String())
+
+	switch v.Kind() {
+	case reflect.Pointer, reflect.Map, reflect.Chan, reflect.Func, reflect.UnsafePointer:
+		builder.WriteString("@" + strconv.FormatUint(uint64(v.Pointer()), 16))
+	case reflect.Slice:
+		builder.WriteString("@" + strconv.FormatUint(uint64(v.Pointer()), 16) + "/" + strconv.Itoa(v.Len()))
+	case reflect.Interface:
+		builder.WriteByte('(')
+		writeIdentity(builder, v.Elem())
+		builder.WriteByte(')')
+	case reflect.Struct:
+		builder.WriteByte('{')
+
+		for i := range v.NumField() {
+			if i > 0 {
+				builder.WriteByte(',')
+			}
+
+			writeIdentity(builder, v.Field(i))
+		}
+
+		builder.WriteByte('}')
+	case reflect.Array:
+		builder.WriteByte('[')
+
+		for i := range v.Len() {
+			if i > 0 {
+				builder.WriteByte(',')
+			}
+
+			writeIdentity(builder, v.Index(i))
+		}
+
+		builder.WriteByte(']')
+	case reflect.String:
+		builder.WriteString(strconv.Quote(v.String()))
+	case reflect.Bool:
+		builder.WriteString("(" + strconv.FormatBool(v.Bool()) + ")")
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		builder.WriteString("(" + strconv.FormatInt(v.Int(), 10) + ")")
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		builder.WriteString("(" + strconv.FormatUint(v.Uint(), 10) + ")")
+	case reflect.Float32, reflect.Float64:
+		builder.WriteString("(" + strconv.FormatFloat(v.Float(), 'g', -1, 64) + ")")
+	case reflect.Complex64, reflect.Complex128:
+		builder.WriteString("(" + strconv.FormatComplex(v.Complex(), 'g', -1, 128) + ")")
+	}
+}
@@ -0,0 +1,200 @@
+package faults_test
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	flt "github.com/PlayerR9/go-fault"
+	"github.com/PlayerR9/go-fault/faults"
+)
+
+// loop is a fault whose cause can be set after its creation; so that cycles can be built.
+type loop struct {
+	flt.Fault
+
+	// cause is the cause of the fault. May be nil.
+	cause flt.Fault
+}
+
+func (l *loop) Embeds() flt.Fault {
+	return l.Fault
+}
+
+func (l *loop) InfoLines() []string {
+	return nil
+}
+
+func (l *loop) Unwrap() flt.Fault {
+	return l.cause
+}
+
+// joiner is a fault whose joined faults can be set after its creation.
+type joiner struct {
+	flt.Fault
+
+	// faults are the joined faults.
+	faults []flt.Fault
+}
+
+func (j *joiner) Embeds() flt.Fault {
+	return j.Fault
+}
+
+func (j *joiner) InfoLines() []string {
+	return nil
+}
+
+func (j *joiner) Unwrap() []flt.Fault {
+	return j.faults
+}
+
+// walked returns the paths and the messages of the faults visited by Walk; skipping the
+// faults reached by embedding, which share the message of the fault that embeds them.
+func walked(fault flt.Fault, opts ...faults.WalkOption) ([]string, []string) {
+	var paths, msgs []string
+
+	for path, f := range faults.Walk(fault, opts...) {
+		if step, ok := path.Last(); ok && step.Relation == faults.EmbedRelation {
+			continue
+		}
+
+		paths = append(paths, path.String())
+		msgs = append(msgs, faults.MessageOf(f))
+	}
+
+	return paths, msgs
+}
+
+func TestWalk(t *testing.T) {
+	a := faults.NewBadParameter("a")
+	b := faults.NewBadParameter("b")
+
+	self := &loop{Fault: faults.NewBadParameter("self")}
+	self.cause = self
+
+	ping := &loop{Fault: faults.NewBadParameter("ping")}
+	pong := &loop{Fault: faults.NewBadParameter("pong"), cause: ping}
+	ping.cause = pong
+
+	group := &joiner{Fault: faults.NewBadParameter("group")}
+	group.faults = []flt.Fault{a, group, a}
+
+	key := faults.NewNoSuchKey("id")
+
+	keys := &joiner{Fault: faults.NewBadParameter("keys")}
+	keys.faults = []flt.Fault{key, key, faults.NewNoSuchKey("id")}
+
+	tests := []struct {
+		name  string
+		fault flt.Fault
+		opts  []faults.WalkOption
+		paths []string
+		msgs  []string
+	}{
+		{
+			name:  "nil",
+			fault: nil,
+		},
+		{
+			name:  "single",
+			fault: a,
+			paths: []string{"$"},
+			msgs:  []string{"a"},
+		},
+		{
+			name:  "cause",
+			fault: faults.Wrap(a, flt.OperationFailed, "w"),
+			paths: []string{"$", "cause"},
+			msgs:  []string{"w", "a"},
+		},
+		{
+			name:  "join",
+			fault: faults.Join(a, b),
+			paths: []string{"$", "join[0]", "join[1]"},
+			msgs:  []string{"joined 2 faults", "a", "b"},
+		},
+		{
+			name:  "cause of a join",
+			fault: faults.Join(faults.Wrap(a, flt.OperationFailed, "w"), b),
+			paths: []string{"$", "join[0]", "join[0].cause", "join[1]"},
+			msgs:  []string{"joined 2 faults", "w", "a", "b"},
+		},
+		{
+			name:  "breadth first",
+			fault: faults.Join(faults.Wrap(a, flt.OperationFailed, "w"), b),
+			opts:  []faults.WalkOption{faults.BreadthFirst()},
+			paths: []string{"$", "join[0]", "join[1]", "join[0].cause"},
+			msgs:  []string{"joined 2 faults", "w", "b", "a"},
+		},
+		{
+			name:  "following joins only",
+			fault: faults.Join(faults.Wrap(a, flt.OperationFailed, "w"), b),
+			opts:  []faults.WalkOption{faults.Following(faults.JoinRelation)},
+			paths: []string{"$", "join[0]", "join[1]"},
+			msgs:  []string{"joined 2 faults", "w", "b"},
+		},
+		{
+			name:  "cycle of one",
+			fault: self,
+			paths: []string{"$"},
+			msgs:  []string{"self"},
+		},
+		{
+			name:  "cycle of two",
+			fault: ping,
+			paths: []string{"$", "cause"},
+			msgs:  []string{"ping", "pong"},
+		},
+		{
+			name:  "join of itself and duplicates",
+			fault: group,
+			paths: []string{"$", "join[0]"},
+			msgs:  []string{"group", "a"},
+		},
+		{
+			name:  "duplicates of typed faults",
+			fault: keys,
+			paths: []string{"$", "join[0]", "join[2]"},
+			msgs:  []string{"keys", `the specified key ("id") does not exist`, `the specified key ("id") does not exist`},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			paths, msgs := walked(tt.fault, tt.opts...)
+
+			if !slices.Equal(paths, tt.paths) {
+				t.Errorf("paths: got %q, want %q", paths, tt.paths)
+			}
+
+			if !slices.Equal(msgs, tt.msgs) {
+				t.Errorf("messages: got %q, want %q", msgs, tt.msgs)
+			}
+		})
+	}
+}
+
+func TestWalkStops(t *testing.T) {
+	fault := faults.Join(faults.NewBadParameter("a"), faults.NewBadParameter("b"))
+
+	var count int
+
+	for range faults.All(fault) {
+		count++
+		break
+	}
+
+	if count != 1 {
+		t.Errorf("visited %d faults after break, want 1", count)
+	}
+}
+
+func TestFindAllAcrossCauses(t *testing.T) {
+	fault := faults.WrapErr(errors.New("disk full"), flt.OperationFailed, "could not save")
+
+	got := faults.FindAll[*faults.ErrFault](fault)
+	if len(got) != 1 {
+		t.Errorf("got %d ErrFault, want 1", len(got))
+	}
+}
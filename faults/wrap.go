@@ -0,0 +1,126 @@
+package faults
+
+import (
+	"fmt"
+
+	flt "github.com/PlayerR9/go-fault"
+)
+
+// WrapFault is a fault that has a cause; that is, the fault that made it occur.
+type WrapFault struct {
+	flt.Fault
+
+	// cause is the cause of the fault. Never nil.
+	cause flt.Fault
+}
+
+// Embeds implements the flt.Fault interface.
+func (wf WrapFault) Embeds() flt.Fault {
+	return wf.Fault
+}
+
+// InfoLines implements the flt.Fault interface.
+//
+// Always returns nil; the cause is rendered by LinesOf as a "Caused by:" section.
+func (wf WrapFault) InfoLines() []string {
+	return nil
+}
+
+// Unwrap returns the cause of the fault.
+//
+// Returns:
+//   - flt.Fault: The cause. Never returns nil.
+func (wf WrapFault) Unwrap() flt.Fault {
+	return wf.cause
+}
+
+// Wrap creates a new fault whose cause is the given one.
+//
+// The level of the fault is the default level of the code, if it has one (see
+// flt.CodeInfoer), and ERROR otherwise.
+//
+// Parameters:
+//   - cause: The cause of the fault.
+//   - code: The code of the fault.
+//   - msg: The message of the fault.
+//   - opts: The options of the fault.
+//
+// Returns:
+//   - flt.Fault: The new fault. Nil if the cause is nil.
+func Wrap[C flt.FaultCode](cause flt.Fault, code C, msg string, opts ...FaultOption) flt.Fault {
+	if cause == nil {
+		return nil
+	}
+
+	level := flt.ERROR
+
+	info, ok := flt.InfoOf(code)
+	if ok {
+		level = info.Level
+	}
+
+	desc := flt.NewDescriptor(level, code, msg)
+
+	wf := &WrapFault{
+		Fault: flt.NewBase(desc),
+		cause: cause,
+	}
+
+	return apply(wf, opts)
+}
+
+// WrapErr is like Wrap but the cause is an error. Unless the error is a flt.Fault, it is
+// turned into a fault with FromErr.
+//
+// Parameters:
+//   - cause: The cause of the fault.
+//   - code: The code of the fault.
+//   - msg: The message of the fault.
+//   - opts: The options of the fault.
+//
+// Returns:
+//   - flt.Fault: The new fault. Nil if the cause is nil.
+func WrapErr[C flt.FaultCode](cause error, code C, msg string, opts ...FaultOption) flt.Fault {
+	if cause == nil {
+		return nil
+	}
+
+	inner, ok := cause.(flt.Fault)
+	if !ok {
+		inner = FromErr(cause)
+	}
+
+	return Wrap(inner, code, msg, opts...)
+}
+
+// Wrapf is like Wrap but the message is formatted with fmt.Sprintf. The arguments that
+// are FaultOptions are applied to the fault rather than formatted.
+//
+// Parameters:
+//   - cause: The cause of the fault.
+//   - code: The code of the fault.
+//   - format: The format of the message.
+//   - args: The arguments of the format, and the options of the fault.
+//
+// Returns:
+//   - flt.Fault: The new fault. Nil if the cause is nil.
+//
+// Example:
+//
+//	fault := faults.Wrapf(cause, flt.OperationFailed, "could not load %q", path, faults.WithAt(pos))
+func Wrapf[C flt.FaultCode](cause flt.Fault, code C, format string, args ...any) flt.Fault {
+	var opts []FaultOption
+
+	values := make([]any, 0, len(args))
+
+	for _, arg := range args {
+		opt, ok := arg.(FaultOption)
+		if ok {
+			opts = append(opts, opt)
+		} else {
+			values = append(values, arg)
+		}
+	}
+
+	return Wrap(cause, code, fmt.Sprintf(format, values...), opts...)
+}
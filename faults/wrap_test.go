@@ -0,0 +1,101 @@
+package faults_test
+
+import (
+	"errors"
+	"testing"
+
+	flt "github.com/PlayerR9/go-fault"
+	"github.com/PlayerR9/go-fault/faults"
+)
+
+func TestWrap(t *testing.T) {
+	bad := faults.NewBadParameter("x must be positive")
+
+	tests := []struct {
+		name  string
+		fault flt.Fault
+		msg   string
+		level flt.FaultLevel
+		cause flt.Fault
+		at    string
+	}{
+		{
+			name:  "fault",
+			fault: faults.Wrap(bad, flt.OperationFailed, "could not parse", faults.WithAt("main.go:12")),
+			msg:   "could not parse",
+			level: flt.ERROR,
+			cause: bad,
+			at:    "main.go:12",
+		},
+		{
+			name:  "level of the code",
+			fault: faults.Wrap(bad, flt.Canceled, "the parse was canceled"),
+			msg:   "the parse was canceled",
+			level: flt.NOTICE,
+			cause: bad,
+		},
+		{
+			name:  "error that is a fault",
+			fault: faults.WrapErr(bad.(error), flt.OperationFailed, "could not parse"),
+			msg:   "could not parse",
+			level: flt.ERROR,
+			cause: bad,
+		},
+		{
+			name:  "formatted with options",
+			fault: faults.Wrapf(bad, flt.OperationFailed, "could not parse %q", "cfg.json", faults.WithAt("main.go:12")),
+			msg:   `could not parse "cfg.json"`,
+			level: flt.ERROR,
+			cause: bad,
+			at:    "main.go:12",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := faults.MessageOf(tt.fault); got != tt.msg {
+				t.Errorf("got the message %q, want %q", got, tt.msg)
+			}
+
+			if got := faults.LevelOf(tt.fault); got != tt.level {
+				t.Errorf("got the level %s, want %s", got, tt.level)
+			}
+
+			if got := flt.Unwrap(tt.fault); got != tt.cause {
+				t.Errorf("got the cause %v, want %v", got, tt.cause)
+			}
+
+			at, _ := faults.Get(tt.fault, faults.AtKey)
+			if at != tt.at {
+				t.Errorf("got the position %q, want %q", at, tt.at)
+			}
+		})
+	}
+}
+
+func TestWrapErr(t *testing.T) {
+	fault := faults.WrapErr(errors.New("disk full"), flt.OperationFailed, "could not save")
+
+	ef, ok := flt.Unwrap(fault).(*faults.ErrFault)
+	if !ok {
+		t.Fatalf("got the cause %T, want *faults.ErrFault", flt.Unwrap(fault))
+	}
+
+	if ef.Err == nil || ef.Err.Error() != "disk full" {
+		t.Errorf("got the error %v, want %q", ef.Err, "disk full")
+	}
+}
+
+func TestWrapNil(t *testing.T) {
+	if got := faults.Wrap(nil, flt.OperationFailed, "x"); got != nil {
+		t.Errorf("Wrap: got %v, want nil", got)
+	}
+
+	if got := faults.WrapErr(nil, flt.OperationFailed, "x"); got != nil {
+		t.Errorf("WrapErr: got %v, want nil", got)
+	}
+
+	if got := faults.Wrapf(nil, flt.OperationFailed, "x %d", 1); got != nil {
+		t.Errorf("Wrapf: got %v, want nil", got)
+	}
+}
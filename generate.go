@@ -1,4 +1,3 @@
 package fault
 
-//go:generate stringer -type=FaultLevel -linecomment
-//go:generate stringer -type=StandardCode
+//go:generate go run ./cmd/faultcodegen -type=StandardCode
@@ -1 +1,96 @@
 package fault
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ExpandMessage fills the placeholders of a message template with the values returned by
+// lookup.
+//
+// A placeholder has the form "{name}" or "{name:verb}", where verb is any fmt verb
+// without the leading "%" (e.g., "{key:q}" quotes the value). When no verb is given, "v"
+// is used. "{{" and "}}" are written as literal braces. Placeholders whose name is not
+// found by lookup are left untouched.
+//
+// Faults only fill the messages of the descriptors created as templates. (See
+// NewTemplateDescriptor.)
+//
+// Parameters:
+//   - template: The message template.
+//   - lookup: The function that returns the value of a placeholder. If nil, no placeholder
+//     is filled.
+//
+// Returns:
+//   - string: The expanded message.
+//
+// Example:
+//
+//	msg := ExpandMessage("the specified key ({key:q}) does not exist", func(name string) (any, bool) {
+//		return "foo", name == "key"
+//	})
+//	// msg == "the specified key (\"foo\") does not exist"
+func ExpandMessage(template string, lookup func(name string) (any, bool)) string {
+	if !strings.ContainsAny(template, "{}") {
+		return template
+	}
+
+	var builder strings.Builder
+
+	for i := 0; i < len(template); i++ {
+		c := template[i]
+
+		if c == '}' {
+			if i+1 < len(template) && template[i+1] == '}' {
+				i++
+			}
+
+			builder.WriteByte('}')
+
+			continue
+		}
+
+		if c != '{' {
+			builder.WriteByte(c)
+
+			continue
+		}
+
+		if i+1 < len(template) && template[i+1] == '{' {
+			builder.WriteByte('{')
+			i++
+
+			continue
+		}
+
+		end := strings.IndexByte(template[i:], '}')
+		if end < 0 {
+			builder.WriteString(template[i:])
+
+			break
+		}
+
+		placeholder := template[i : i+end+1]
+		i += end
+
+		name, verb, ok := strings.Cut(placeholder[1:len(placeholder)-1], ":")
+		if !ok || verb == "" {
+			verb = "v"
+		}
+
+		var value any
+		var found bool
+
+		if lookup != nil {
+			value, found = lookup(name)
+		}
+
+		if !found {
+			builder.WriteString(placeholder)
+		} else {
+			builder.WriteString(fmt.Sprintf("%"+verb, value))
+		}
+	}
+
+	return builder.String()
+}
@@ -0,0 +1,380 @@
+package fault
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"sync/atomic"
+)
+
+// EventKind is the kind of an event observed by the hooks.
+type EventKind int
+
+const (
+	// CreateEvent is emitted when a fault is created; by FaultDescriber.Init or, once the
+	// fault is set up, by the constructors that use NewBase. (See EmitCreate.)
+	CreateEvent EventKind = iota
+
+	// ThrowEvent is emitted when a frame is added to a fault's stack trace.
+	ThrowEvent
+
+	// JoinEvent is emitted when faults are joined into a single fault.
+	JoinEvent
+
+	// RecoverEvent is emitted when a panic is recovered into a fault.
+	RecoverEvent
+)
+
+// String implements the fmt.Stringer interface.
+func (k EventKind) String() string {
+	switch k {
+	case CreateEvent:
+		return "create"
+	case ThrowEvent:
+		return "throw"
+	case JoinEvent:
+		return "join"
+	case RecoverEvent:
+		return "recover"
+	default:
+		return "EventKind(" + strconv.Itoa(int(k)) + ")"
+	}
+}
+
+// Event is an event observed by the hooks.
+type Event struct {
+	// Kind is the kind of the event.
+	Kind EventKind
+
+	// Fault is the fault the event is about. For JoinEvent, it is the joined fault and,
+	// for RecoverEvent, the fault the panic was recovered into.
+	Fault Fault
+
+	// Frame is the frame that was added. Only set for ThrowEvent.
+	Frame string
+
+	// Faults are the faults that were joined. Only set for JoinEvent.
+	Faults []Fault
+
+	// Value is the value that was recovered. Only set for RecoverEvent.
+	Value any
+}
+
+// Hook is a function that observes events. Hooks are called synchronously by the code
+// that emits the event; as such, they must be fast. A panicking hook does not affect the
+// emitter nor the other hooks.
+type Hook func(event Event)
+
+// hookEntry is a registered hook.
+type hookEntry struct {
+	// id identifies the entry for its removal.
+	id uint64
+
+	// kind is the kind of events the hook observes. Negative for all kinds.
+	kind EventKind
+
+	// fn is the hook.
+	fn Hook
+}
+
+// Hooks is a registry of hooks. Emitting an event does not take any lock, which makes
+// it cheap when no hook is registered.
+type Hooks struct {
+	// mu serializes the registrations and removals.
+	mu sync.Mutex
+
+	// entries is the current, read-only, snapshot of the registered hooks.
+	entries atomic.Pointer[[]hookEntry]
+
+	// next_id is the identifier of the next registered hook.
+	next_id uint64
+}
+
+// NewHooks creates a new, empty, registry of hooks.
+//
+// Returns:
+//   - *Hooks: The new registry. Never returns nil.
+func NewHooks() *Hooks {
+	return &Hooks{}
+}
+
+// add registers a hook.
+//
+// Parameters:
+//   - kind: The kind of events the hook observes. Negative for all kinds.
+//   - fn: The hook.
+//
+// Returns:
+//   - func(): The function that removes the hook. Never returns nil.
+func (h *Hooks) add(kind EventKind, fn Hook) func() {
+	if h == nil || fn == nil {
+		return func() {}
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.next_id++
+	id := h.next_id
+
+	var entries []hookEntry
+
+	old := h.entries.Load()
+	if old != nil {
+		entries = append(entries, *old...)
+	}
+
+	entries = append(entries, hookEntry{
+		id:   id,
+		kind: kind,
+		fn:   fn,
+	})
+
+	h.entries.Store(&entries)
+
+	var once sync.Once
+
+	return func() {
+		once.Do(func() {
+			h.remove(id)
+		})
+	}
+}
+
+// remove removes the hook with the given identifier.
+//
+// Parameters:
+//   - id: The identifier of the hook.
+func (h *Hooks) remove(id uint64) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	old := h.entries.Load()
+	if old == nil {
+		return
+	}
+
+	entries := make([]hookEntry, 0, len(*old))
+
+	for _, entry := range *old {
+		if entry.id != id {
+			entries = append(entries, entry)
+		}
+	}
+
+	h.entries.Store(&entries)
+}
+
+// Subscribe registers a hook that observes every kind of event.
+//
+// Parameters:
+//   - fn: The hook. If nil, nothing is registered.
+//
+// Returns:
+//   - func(): The function that removes the hook. Never returns nil.
+func (h *Hooks) Subscribe(fn Hook) func() {
+	return h.add(-1, fn)
+}
+
+// OnCreate registers a hook that observes the creation of faults.
+//
+// Parameters:
+//   - fn: The hook. If nil, nothing is registered.
+//
+// Returns:
+//   - func(): The function that removes the hook. Never returns nil.
+func (h *Hooks) OnCreate(fn func(fault Fault)) func() {
+	if fn == nil {
+		return func() {}
+	}
+
+	return h.add(CreateEvent, func(event Event) {
+		fn(event.Fault)
+	})
+}
+
+// OnThrow registers a hook that observes the frames added to the faults' stack traces.
+//
+// Parameters:
+//   - fn: The hook. If nil, nothing is registered.
+//
+// Returns:
+//   - func(): The function that removes the hook. Never returns nil.
+func (h *Hooks) OnThrow(fn func(fault Fault, frame string)) func() {
+	if fn == nil {
+		return func() {}
+	}
+
+	return h.add(ThrowEvent, func(event Event) {
+		fn(event.Fault, event.Frame)
+	})
+}
+
+// OnJoin registers a hook that observes the joining of faults.
+//
+// Parameters:
+//   - fn: The hook. If nil, nothing is registered.
+//
+// Returns:
+//   - func(): The function that removes the hook. Never returns nil.
+func (h *Hooks) OnJoin(fn func(joined Fault, faults []Fault)) func() {
+	if fn == nil {
+		return func() {}
+	}
+
+	return h.add(JoinEvent, func(event Event) {
+		fn(event.Fault, event.Faults)
+	})
+}
+
+// OnRecover registers a hook that observes the panics recovered into faults.
+//
+// Parameters:
+//   - fn: The hook. If nil, nothing is registered.
+//
+// Returns:
+//   - func(): The function that removes the hook. Never returns nil.
+func (h *Hooks) OnRecover(fn func(fault Fault, value any)) func() {
+	if fn == nil {
+		return func() {}
+	}
+
+	return h.add(RecoverEvent, func(event Event) {
+		fn(event.Fault, event.Value)
+	})
+}
+
+// Emit calls, in order of registration, the hooks that observe the kind of the event.
+//
+// Parameters:
+//   - event: The event to emit.
+func (h *Hooks) Emit(event Event) {
+	if h == nil {
+		return
+	}
+
+	entries := h.entries.Load()
+	if entries == nil {
+		return
+	}
+
+	for _, entry := range *entries {
+		if entry.kind < 0 || entry.kind == event.Kind {
+			call(entry.fn, event)
+		}
+	}
+}
+
+// call calls the hook; recovering from any panic it may raise.
+//
+// Parameters:
+//   - fn: The hook to call.
+//   - event: The event to pass to the hook.
+func call(fn Hook, event Event) {
+	defer func() {
+		_ = recover()
+	}()
+
+	fn(event)
+}
+
+var (
+	// GlobalHooks is the registry of the hooks that observe every event.
+	GlobalHooks *Hooks = NewHooks()
+)
+
+// OnCreate is like Hooks.OnCreate but registers the hook in GlobalHooks.
+func OnCreate(fn func(fault Fault)) func() {
+	return GlobalHooks.OnCreate(fn)
+}
+
+// OnThrow is like Hooks.OnThrow but registers the hook in GlobalHooks.
+func OnThrow(fn func(fault Fault, frame string)) func() {
+	return GlobalHooks.OnThrow(fn)
+}
+
+// OnJoin is like Hooks.OnJoin but registers the hook in GlobalHooks.
+func OnJoin(fn func(joined Fault, faults []Fault)) func() {
+	return GlobalHooks.OnJoin(fn)
+}
+
+// OnRecover is like Hooks.OnRecover but registers the hook in GlobalHooks.
+func OnRecover(fn func(fault Fault, value any)) func() {
+	return GlobalHooks.OnRecover(fn)
+}
+
+// Subscribe is like Hooks.Subscribe but registers the hook in GlobalHooks.
+func Subscribe(fn Hook) func() {
+	return GlobalHooks.Subscribe(fn)
+}
+
+// hooksKey is the key under which the scoped hooks are stored in a context.Context.
+type hooksKey struct{}
+
+// WithHooks returns a copy of ctx whose events are also observed by the given hooks. The
+// hooks of the enclosing contexts keep observing them as well.
+//
+// Only the events emitted with a context.Context, such as the creation of faults with
+// NewCtx, are observed by scoped hooks.
+//
+// Parameters:
+//   - ctx: The parent context. If nil, context.Background() is used.
+//   - hooks: The scoped hooks.
+//
+// Returns:
+//   - context.Context: The new context. Never returns nil.
+func WithHooks(ctx context.Context, hooks *Hooks) context.Context {
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	if hooks == nil {
+		return ctx
+	}
+
+	parent, _ := ctx.Value(hooksKey{}).([]*Hooks)
+
+	scoped := make([]*Hooks, 0, len(parent)+1)
+	scoped = append(scoped, parent...)
+	scoped = append(scoped, hooks)
+
+	return context.WithValue(ctx, hooksKey{}, scoped)
+}
+
+// EmitCreate emits the CreateEvent of a new fault. Constructors that set the fault up,
+// such as the ones that add keys or apply options, should create it with NewBase and call
+// EmitCreate once it is complete; so that the hooks see the fault as it is returned.
+//
+// Parameters:
+//   - ctx: The context of the event. May be nil.
+//   - fault: The new fault. Does nothing if nil.
+func EmitCreate(ctx context.Context, fault Fault) {
+	if fault == nil {
+		return
+	}
+
+	Emit(ctx, Event{
+		Kind:  CreateEvent,
+		Fault: fault,
+	})
+}
+
+// Emit emits the event to GlobalHooks and then to the hooks scoped to ctx, from the
+// outermost to the innermost.
+//
+// Parameters:
+//   - ctx: The context of the event. May be nil.
+//   - event: The event to emit.
+func Emit(ctx context.Context, event Event) {
+	GlobalHooks.Emit(event)
+
+	if ctx == nil {
+		return
+	}
+
+	scoped, _ := ctx.Value(hooksKey{}).([]*Hooks)
+
+	for _, hooks := range scoped {
+		hooks.Emit(event)
+	}
+}
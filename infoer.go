@@ -2,3 +2,28 @@ package fault
 
 type Infoer interface {
 }
+
+// Translator translates the texts of a fault into a given language.
+type Translator interface {
+	// Translate translates the given text.
+	//
+	// Parameters:
+	//   - text: The text to translate.
+	//
+	// Returns:
+	//   - string: The translated text. If no translation exists, the text itself.
+	Translate(text string) string
+}
+
+// LocalizedInfoer is implemented by faults whose additional information can be
+// translated.
+type LocalizedInfoer interface {
+	// InfoLinesIn is like Fault.InfoLines but translates its texts with tr.
+	//
+	// Parameters:
+	//   - tr: The translator to use. If nil, no translation is done.
+	//
+	// Returns:
+	//   - []string: The fault's additional information.
+	InfoLinesIn(tr Translator) []string
+}
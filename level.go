@@ -0,0 +1,298 @@
+package fault
+
+import (
+	"flag"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// levelInfo is the information about a fault level that is known by the level registry.
+type levelInfo struct {
+	// name is the canonical name of the level.
+	name string
+
+	// severity is the position of the level in the severity scale. The higher the value,
+	// the more severe the level.
+	severity int
+}
+
+var (
+	// levels_mu guards the level registry.
+	levels_mu sync.RWMutex
+
+	// levels is the registry of all the known levels.
+	levels map[FaultLevel]levelInfo
+
+	// level_names maps the upper-case names and aliases of the known levels to their level.
+	level_names map[string]FaultLevel
+
+	// next_level is the value that will be assigned to the next registered level.
+	next_level FaultLevel
+)
+
+func init() {
+	levels = map[FaultLevel]levelInfo{
+		UnknownLevel: {name: "UNKNOWN LEVEL", severity: 0},
+		FATAL:        {name: "FATAL", severity: 500},
+		ERROR:        {name: "ERROR", severity: 400},
+		WARNING:      {name: "WARNING", severity: 300},
+		NOTICE:       {name: "NOTICE", severity: 200},
+		DEBUG:        {name: "DEBUG", severity: 100},
+	}
+
+	level_names = map[string]FaultLevel{
+		"UNKNOWN LEVEL": UnknownLevel,
+		"UNKNOWN":       UnknownLevel,
+		"FATAL":         FATAL,
+		"ERROR":         ERROR,
+		"ERR":           ERROR,
+		"WARNING":       WARNING,
+		"WARN":          WARNING,
+		"NOTICE":        NOTICE,
+		"DEBUG":         DEBUG,
+	}
+
+	next_level = DEBUG + 1
+}
+
+// String implements the fmt.Stringer interface.
+//
+// Levels that are neither built-in nor registered are printed as "FaultLevel(<value>)".
+func (l FaultLevel) String() string {
+	levels_mu.RLock()
+	info, ok := levels[l]
+	levels_mu.RUnlock()
+
+	if !ok {
+		return "FaultLevel(" + strconv.Itoa(int(l)) + ")"
+	}
+
+	return info.name
+}
+
+// Severity returns the position of the level in the severity scale. The higher the value,
+// the more severe the level.
+//
+// Returns:
+//   - int: The severity of the level. 0 if the level is UnknownLevel or it is not known.
+//
+// The built-in levels have the following severities: FATAL (500), ERROR (400),
+// WARNING (300), NOTICE (200) and DEBUG (100).
+func (l FaultLevel) Severity() int {
+	levels_mu.RLock()
+	info := levels[l]
+	levels_mu.RUnlock()
+
+	return info.severity
+}
+
+// Compare compares the severity of two levels.
+//
+// Parameters:
+//   - other: The level to compare with.
+//
+// Returns:
+//   - int: -1 if the receiver is less severe than other, 1 if it is more severe, and 0
+//     if both are equally severe.
+func (l FaultLevel) Compare(other FaultLevel) int {
+	a, b := l.Severity(), other.Severity()
+
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// AtLeast checks whether the level is at least as severe as the other level.
+//
+// Parameters:
+//   - other: The level to compare with.
+//
+// Returns:
+//   - bool: True if the receiver is at least as severe as other, false otherwise.
+func (l FaultLevel) AtLeast(other FaultLevel) bool {
+	return l.Compare(other) >= 0
+}
+
+// IsKnown checks whether the level is either built-in or registered.
+//
+// Returns:
+//   - bool: True if the level is known, false otherwise.
+func (l FaultLevel) IsKnown() bool {
+	levels_mu.RLock()
+	_, ok := levels[l]
+	levels_mu.RUnlock()
+
+	return ok
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+//
+// Errors:
+//   - error: If the level is neither built-in nor registered.
+func (l FaultLevel) MarshalText() ([]byte, error) {
+	levels_mu.RLock()
+	info, ok := levels[l]
+	levels_mu.RUnlock()
+
+	if !ok {
+		return nil, fmt.Errorf("cannot marshal unknown fault level (%d)", int(l))
+	}
+
+	return []byte(info.name), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface. The text is parsed
+// with ParseLevel.
+//
+// Errors:
+//   - error: If the receiver is nil or the text is not a known level.
+func (l *FaultLevel) UnmarshalText(text []byte) error {
+	if l == nil {
+		return fmt.Errorf("receiver must be non-nil")
+	}
+
+	level, err := ParseLevel(string(text))
+	if err != nil {
+		return err
+	}
+
+	*l = level
+
+	return nil
+}
+
+// ParseLevel parses a level from its name. The parsing is case-insensitive, ignores
+// leading and trailing spaces, and accepts aliases such as "warn" or "err".
+//
+// Parameters:
+//   - str: The string to parse.
+//
+// Returns:
+//   - FaultLevel: The parsed level. UnknownLevel if the string is not a known level.
+//   - error: An error if the string is not a known level.
+func ParseLevel(str string) (FaultLevel, error) {
+	key := strings.ToUpper(strings.TrimSpace(str))
+
+	levels_mu.RLock()
+	level, ok := level_names[key]
+	levels_mu.RUnlock()
+
+	if !ok {
+		return UnknownLevel, fmt.Errorf("unknown fault level (%q)", str)
+	}
+
+	return level, nil
+}
+
+// RegisterLevel registers a new level with the given name, severity and aliases. Once
+// registered, the level can be printed, parsed and compared just like the built-in ones.
+//
+// Parameters:
+//   - name: The canonical name of the level. It is case-insensitive for parsing but
+//     printed as given.
+//   - severity: The position of the level in the severity scale. For instance, 450 places
+//     the level between FATAL and ERROR, and 50 places it below DEBUG.
+//   - aliases: Additional names that ParseLevel accepts for the level.
+//
+// Returns:
+//   - FaultLevel: The newly registered level.
+//   - error: An error if the name is empty or if the name or any alias is already taken.
+//
+// Example:
+//
+//	var TRACE, _ = fault.RegisterLevel("TRACE", 50)
+//	var CRITICAL, _ = fault.RegisterLevel("CRITICAL", 450, "CRIT")
+func RegisterLevel(name string, severity int, aliases ...string) (FaultLevel, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return UnknownLevel, fmt.Errorf("level name must be non-empty")
+	}
+
+	keys := make([]string, 0, len(aliases)+1)
+	keys = append(keys, strings.ToUpper(name))
+
+	for _, alias := range aliases {
+		alias = strings.TrimSpace(alias)
+		if alias != "" {
+			keys = append(keys, strings.ToUpper(alias))
+		}
+	}
+
+	levels_mu.Lock()
+	defer levels_mu.Unlock()
+
+	for _, key := range keys {
+		_, ok := level_names[key]
+		if ok {
+			return UnknownLevel, fmt.Errorf("level name (%q) is already registered", key)
+		}
+	}
+
+	level := next_level
+	next_level++
+
+	levels[level] = levelInfo{
+		name:     name,
+		severity: severity,
+	}
+
+	for _, key := range keys {
+		level_names[key] = level
+	}
+
+	return level, nil
+}
+
+// levelFlag is the flag.Value adapter of a FaultLevel.
+type levelFlag struct {
+	// level is the level that is being set.
+	level *FaultLevel
+}
+
+// String implements the flag.Value interface.
+func (lf levelFlag) String() string {
+	if lf.level == nil {
+		return ""
+	}
+
+	return lf.level.String()
+}
+
+// Set implements the flag.Value interface.
+func (lf levelFlag) Set(str string) error {
+	return lf.level.UnmarshalText([]byte(str))
+}
+
+// Get implements the flag.Getter interface.
+func (lf levelFlag) Get() any {
+	if lf.level == nil {
+		return UnknownLevel
+	}
+
+	return *lf.level
+}
+
+// LevelFlag returns a flag.Value that sets the given level.
+//
+// Parameters:
+//   - level: The level to set. Its current value is used as the default value.
+//
+// Returns:
+//   - flag.Value: The flag.Value adapter. Never returns nil.
+//
+// Example:
+//
+//	level := fault.WARNING
+//	flag.Var(fault.LevelFlag(&level), "level", "minimum fault level to report")
+func LevelFlag(level *FaultLevel) flag.Value {
+	return levelFlag{
+		level: level,
+	}
+}
@@ -0,0 +1,234 @@
+package fault_test
+
+import (
+	"flag"
+	"io"
+	"testing"
+
+	flt "github.com/PlayerR9/go-fault"
+)
+
+func TestParseLevel(t *testing.T) {
+	tests := []struct {
+		name    string
+		str     string
+		want    flt.FaultLevel
+		wantErr bool
+	}{
+		{name: "canonical name", str: "WARNING", want: flt.WARNING},
+		{name: "lower case", str: "fatal", want: flt.FATAL},
+		{name: "surrounding spaces", str: "  Notice\t", want: flt.NOTICE},
+		{name: "warn alias", str: "warn", want: flt.WARNING},
+		{name: "err alias", str: "Err", want: flt.ERROR},
+		{name: "unknown alias", str: "unknown", want: flt.UnknownLevel},
+		{name: "unknown level", str: "UNKNOWN LEVEL", want: flt.UnknownLevel},
+		{name: "empty", str: "", want: flt.UnknownLevel, wantErr: true},
+		{name: "not a level", str: "loud", want: flt.UnknownLevel, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := flt.ParseLevel(tt.str)
+
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("got the error %v, want an error: %t", err, tt.wantErr)
+			}
+
+			if got != tt.want {
+				t.Errorf("got %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRegisterLevel(t *testing.T) {
+	critical, err := flt.RegisterLevel("Critical", 450, "crit", " ")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name     string
+		level    string
+		severity int
+		aliases  []string
+		wantErr  bool
+	}{
+		{name: "empty name", level: " ", severity: 10, wantErr: true},
+		{name: "built-in name", level: "error", severity: 10, wantErr: true},
+		{name: "built-in alias", level: "WARN", severity: 10, wantErr: true},
+		{name: "registered name", level: "CRITICAL", severity: 10, wantErr: true},
+		{name: "alias of a built-in name", level: "Severe", severity: 10, aliases: []string{"fatal"}, wantErr: true},
+		{name: "alias of a registered alias", level: "Severe", severity: 10, aliases: []string{"CRIT"}, wantErr: true},
+		{name: "new name", level: "Trace", severity: 50, aliases: []string{"trc"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := flt.RegisterLevel(tt.level, tt.severity, tt.aliases...)
+
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("got the error %v, want an error: %t", err, tt.wantErr)
+			}
+
+			if tt.wantErr {
+				if got != flt.UnknownLevel {
+					t.Errorf("got %s, want %s", got, flt.UnknownLevel)
+				}
+
+				return
+			}
+
+			if !got.IsKnown() || got.Severity() != tt.severity {
+				t.Errorf("got %s of severity %d, want a known level of severity %d", got, got.Severity(), tt.severity)
+			}
+		})
+	}
+
+	// A failed registration does not take any of its names.
+	_, err = flt.ParseLevel("Severe")
+	if err == nil {
+		t.Errorf("got the name of a failed registration taken, want it free")
+	}
+
+	if got := critical.String(); got != "Critical" {
+		t.Errorf("got the name %q, want %q", got, "Critical")
+	}
+
+	for _, str := range []string{"critical", "CRIT"} {
+		got, err := flt.ParseLevel(str)
+		if err != nil || got != critical {
+			t.Errorf("ParseLevel(%q): got %s and %v, want %s", str, got, err, critical)
+		}
+	}
+
+	if !critical.AtLeast(flt.ERROR) || critical.AtLeast(flt.FATAL) {
+		t.Errorf("got %s out of place, want it between ERROR and FATAL", critical)
+	}
+}
+
+func TestLevelText(t *testing.T) {
+	tests := []struct {
+		name  string
+		level flt.FaultLevel
+		text  string
+	}{
+		{name: "fatal", level: flt.FATAL, text: "FATAL"},
+		{name: "error", level: flt.ERROR, text: "ERROR"},
+		{name: "warning", level: flt.WARNING, text: "WARNING"},
+		{name: "notice", level: flt.NOTICE, text: "NOTICE"},
+		{name: "debug", level: flt.DEBUG, text: "DEBUG"},
+		{name: "unknown", level: flt.UnknownLevel, text: "UNKNOWN LEVEL"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			text, err := tt.level.MarshalText()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if string(text) != tt.text {
+				t.Errorf("got %q, want %q", text, tt.text)
+			}
+
+			var got flt.FaultLevel
+
+			err = got.UnmarshalText(text)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if got != tt.level {
+				t.Errorf("got %s, want %s", got, tt.level)
+			}
+		})
+	}
+}
+
+func TestLevelTextErrors(t *testing.T) {
+	_, err := flt.FaultLevel(-42).MarshalText()
+	if err == nil {
+		t.Errorf("MarshalText: got no error for an unknown level, want one")
+	}
+
+	level := flt.ERROR
+
+	err = level.UnmarshalText([]byte("loud"))
+	if err == nil {
+		t.Errorf("UnmarshalText: got no error for an unknown name, want one")
+	}
+
+	if level != flt.ERROR {
+		t.Errorf("UnmarshalText: got the level set to %s, want it left %s", level, flt.ERROR)
+	}
+
+	var nil_level *flt.FaultLevel
+
+	err = nil_level.UnmarshalText([]byte("ERROR"))
+	if err == nil {
+		t.Errorf("UnmarshalText: got no error for a nil receiver, want one")
+	}
+}
+
+func TestLevelFlag(t *testing.T) {
+	tests := []struct {
+		name    string
+		args    []string
+		want    flt.FaultLevel
+		wantErr bool
+	}{
+		{name: "default", args: nil, want: flt.WARNING},
+		{name: "name", args: []string{"-level", "debug"}, want: flt.DEBUG},
+		{name: "alias", args: []string{"-level=err"}, want: flt.ERROR},
+		{name: "unknown", args: []string{"-level", "loud"}, want: flt.WARNING, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			level := flt.WARNING
+
+			fs := flag.NewFlagSet("test", flag.ContinueOnError)
+			fs.SetOutput(io.Discard)
+			fs.Var(flt.LevelFlag(&level), "level", "minimum fault level")
+
+			err := fs.Parse(tt.args)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("got the error %v, want an error: %t", err, tt.wantErr)
+			}
+
+			if level != tt.want {
+				t.Errorf("got %s, want %s", level, tt.want)
+			}
+
+			getter := fs.Lookup("level").Value.(flag.Getter)
+			if got := getter.Get(); got != tt.want {
+				t.Errorf("Get: got %v, want %s", got, tt.want)
+			}
+
+			if got := getter.String(); got != tt.want.String() {
+				t.Errorf("String: got %q, want %q", got, tt.want.String())
+			}
+
+			if got := fs.Lookup("level").DefValue; got != "WARNING" {
+				t.Errorf("got the default value %q, want %q", got, "WARNING")
+			}
+		})
+	}
+}
+
+func TestLevelFlagNil(t *testing.T) {
+	lf := flt.LevelFlag(nil)
+
+	if got := lf.String(); got != "" {
+		t.Errorf("String: got %q, want the empty string", got)
+	}
+
+	if got := lf.(flag.Getter).Get(); got != flt.UnknownLevel {
+		t.Errorf("Get: got %v, want %s", got, flt.UnknownLevel)
+	}
+
+	if err := lf.Set("ERROR"); err == nil {
+		t.Errorf("Set: got no error, want one")
+	}
+}
@@ -0,0 +1,209 @@
+package fault
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"path"
+	"strings"
+	"sync"
+)
+
+const (
+	// Redacted is the text that replaces sensitive values when they are masked.
+	Redacted string = "[REDACTED]"
+)
+
+// RedactMode specifies how a sensitive value is hidden.
+type RedactMode int
+
+const (
+	// RedactMask replaces the value with Redacted.
+	RedactMask RedactMode = iota
+
+	// RedactHash replaces the value with a stable hash of it. Equal values give equal
+	// hashes, which allows to correlate them without revealing them.
+	RedactHash
+)
+
+// Redactor is implemented by values that know how to hide themselves. Unless an
+// unredacted view is requested, such values are always rendered with Redact; regardless
+// of the key they are stored under.
+type Redactor interface {
+	// Redact returns the redacted representation of the value.
+	//
+	// Returns:
+	//   - string: The redacted representation of the value. (e.g., "****1234")
+	Redact() string
+}
+
+// SensitiveViewer is implemented by translators that request the sensitive values to be
+// rendered unredacted. See Unredacted.
+type SensitiveViewer interface {
+	// ShowSensitive tells whether sensitive values should be rendered unredacted.
+	//
+	// Returns:
+	//   - bool: True if sensitive values should be rendered unredacted, false otherwise.
+	ShowSensitive() bool
+}
+
+// unredacted is the Translator returned by Unredacted.
+type unredacted struct {
+	// tr is the underlying translator. May be nil.
+	tr Translator
+}
+
+// Translate implements the Translator interface.
+func (u unredacted) Translate(text string) string {
+	if u.tr == nil {
+		return text
+	}
+
+	return u.tr.Translate(text)
+}
+
+// ShowSensitive implements the SensitiveViewer interface.
+//
+// Always returns true.
+func (u unredacted) ShowSensitive() bool {
+	return true
+}
+
+// Unredacted wraps a translator so that the sensitive values are rendered as-is. This is
+// the only way to obtain an unredacted view of a fault and it should never be used for
+// logs.
+//
+// Parameters:
+//   - tr: The translator to wrap. May be nil, in which case no translation is done.
+//
+// Returns:
+//   - Translator: The wrapping translator. Never returns nil.
+func Unredacted(tr Translator) Translator {
+	return unredacted{
+		tr: tr,
+	}
+}
+
+// ShowsSensitive tells whether the translator requests an unredacted view.
+//
+// Parameters:
+//   - tr: The translator to check.
+//
+// Returns:
+//   - bool: True if tr implements SensitiveViewer and requests an unredacted view.
+func ShowsSensitive(tr Translator) bool {
+	if tr == nil {
+		return false
+	}
+
+	sv, ok := tr.(SensitiveViewer)
+	return ok && sv.ShowSensitive()
+}
+
+// sensitivePattern is a global pattern of sensitive keys.
+type sensitivePattern struct {
+	// pattern is the lower-case path.Match pattern.
+	pattern string
+
+	// mode is how the matching values are hidden.
+	mode RedactMode
+}
+
+var (
+	// sensitive_mu guards sensitive_patterns.
+	sensitive_mu sync.RWMutex
+
+	// sensitive_patterns are the global patterns of sensitive keys.
+	sensitive_patterns []sensitivePattern
+)
+
+func init() {
+	for _, pattern := range []string{"*password*", "*passwd*", "*secret*", "*token*", "authorization", "*api_key*", "*apikey*"} {
+		sensitive_patterns = append(sensitive_patterns, sensitivePattern{
+			pattern: pattern,
+			mode:    RedactMask,
+		})
+	}
+}
+
+// RegisterSensitiveKeys marks, for every fault, all the context keys that match any of the
+// patterns as sensitive. The patterns follow the syntax of path.Match and are matched
+// case-insensitively.
+//
+// By default, keys such as "password", "api_token" or "authorization" are already
+// sensitive.
+//
+// Parameters:
+//   - mode: How the matching values are hidden.
+//   - patterns: The patterns of the sensitive keys.
+//
+// Returns:
+//   - error: An error if any of the patterns is malformed. In that case, no pattern
+//     is registered.
+func RegisterSensitiveKeys(mode RedactMode, patterns ...string) error {
+	tmp := make([]sensitivePattern, 0, len(patterns))
+
+	for _, pattern := range patterns {
+		pattern = strings.ToLower(pattern)
+
+		_, err := path.Match(pattern, "")
+		if err != nil {
+			return fmt.Errorf("invalid sensitive key pattern (%q): %w", pattern, err)
+		}
+
+		tmp = append(tmp, sensitivePattern{
+			pattern: pattern,
+			mode:    mode,
+		})
+	}
+
+	sensitive_mu.Lock()
+	sensitive_patterns = append(sensitive_patterns, tmp...)
+	sensitive_mu.Unlock()
+
+	return nil
+}
+
+// SensitiveKey checks whether the key matches any of the global patterns of sensitive
+// keys.
+//
+// Parameters:
+//   - key: The key to check.
+//
+// Returns:
+//   - RedactMode: How the values of the key are hidden. Only valid if the key is sensitive.
+//   - bool: True if the key is sensitive, false otherwise.
+func SensitiveKey(key string) (RedactMode, bool) {
+	key = strings.ToLower(key)
+
+	sensitive_mu.RLock()
+	defer sensitive_mu.RUnlock()
+
+	for _, sp := range sensitive_patterns {
+		ok, _ := path.Match(sp.pattern, key)
+		if ok {
+			return sp.mode, true
+		}
+	}
+
+	return RedactMask, false
+}
+
+// RedactValue hides a value according to the given mode.
+//
+// Parameters:
+//   - value: The value to hide.
+//   - mode: How to hide the value.
+//
+// Returns:
+//   - string: The redacted value. For RedactHash, it has the form "[REDACTED:<hash>]"
+//     where <hash> is the first 12 hexadecimal digits of the SHA-256 of the value.
+func RedactValue(value any, mode RedactMode) string {
+	if mode != RedactHash {
+		return Redacted
+	}
+
+	sum := sha256.Sum256([]byte(fmt.Sprintf("%v", value)))
+
+	return "[REDACTED:" + hex.EncodeToString(sum[:])[:12] + "]"
+}
@@ -1,27 +1,106 @@
 package fault
 
-// StandardCode is a set of common, standard fault codes.
+// StandardCode is a set of common, standard fault codes. Its methods, including its
+// metadata (see CodeInfo), are generated by faultcodegen from the comments below.
 type StandardCode int
 
 const (
 	// Invalid specifies when faults are constructed in a way that is not expected.
 	// This is used mostly for internal usage. However, users may use this code
 	// when constructing faults in their own code.
-	Invalid StandardCode = iota - 1
+	Invalid StandardCode = iota - 1 // level:"FATAL" http:"500" doc:"https://pkg.go.dev/github.com/PlayerR9/go-fault#Invalid"
 
 	// UnknownCode specifies when a fault is created but no code was specified.
-	UnknownCode
+	UnknownCode // level:"ERROR" http:"500" doc:"https://pkg.go.dev/github.com/PlayerR9/go-fault#UnknownCode"
 
 	// FaultJoin is the fault code for when a fault is joined.
-	FaultJoin
+	FaultJoin // level:"ERROR" http:"500" doc:"https://pkg.go.dev/github.com/PlayerR9/go-fault#FaultJoin"
 
 	// BadParameter specifies a broad category of faults that are caused by unexpected
 	// parameters.
-	BadParameter
+	BadParameter // level:"ERROR" http:"400" doc:"https://pkg.go.dev/github.com/PlayerR9/go-fault#BadParameter"
 
 	// OperationFailed specifies a broad category of faults that are returned by functions
 	// when they fail.
-	OperationFailed
+	OperationFailed // level:"ERROR" http:"500" doc:"https://pkg.go.dev/github.com/PlayerR9/go-fault#OperationFailed"
+
+	// NotFound specifies that a requested entity, such as a file or a record, does not exist.
+	//
+	// Its default level is ERROR.
+	NotFound // level:"ERROR" http:"404" doc:"https://pkg.go.dev/github.com/PlayerR9/go-fault#NotFound"
+
+	// AlreadyExists specifies that an entity that was meant to be created already exists.
+	//
+	// Its default level is ERROR.
+	AlreadyExists // level:"ERROR" http:"409" doc:"https://pkg.go.dev/github.com/PlayerR9/go-fault#AlreadyExists"
+
+	// PermissionDenied specifies that the caller is identified but not allowed to perform
+	// the operation.
+	//
+	// Its default level is ERROR.
+	PermissionDenied // level:"ERROR" http:"403" doc:"https://pkg.go.dev/github.com/PlayerR9/go-fault#PermissionDenied"
+
+	// Unauthenticated specifies that the caller could not be identified; such as when its
+	// credentials are missing or invalid.
+	//
+	// Its default level is ERROR.
+	Unauthenticated // level:"ERROR" http:"401" doc:"https://pkg.go.dev/github.com/PlayerR9/go-fault#Unauthenticated"
+
+	// Timeout specifies that an operation did not complete before its deadline.
+	//
+	// Its default level is ERROR.
+	Timeout // level:"ERROR" http:"504" doc:"https://pkg.go.dev/github.com/PlayerR9/go-fault#Timeout"
+
+	// Canceled specifies that an operation was canceled, usually by the caller. As it is
+	// requested, it is not an error in itself.
+	//
+	// Its default level is NOTICE.
+	Canceled // level:"NOTICE" http:"499" doc:"https://pkg.go.dev/github.com/PlayerR9/go-fault#Canceled"
+
+	// Unavailable specifies that a service is temporarily unable to handle the operation.
+	// Retrying later may succeed.
+	//
+	// Its default level is ERROR.
+	Unavailable // level:"ERROR" http:"503" doc:"https://pkg.go.dev/github.com/PlayerR9/go-fault#Unavailable"
+
+	// ResourceExhausted specifies that a resource, such as a quota or the disk space, has
+	// run out.
+	//
+	// Its default level is WARNING.
+	ResourceExhausted // level:"WARNING" http:"429" doc:"https://pkg.go.dev/github.com/PlayerR9/go-fault#ResourceExhausted"
+
+	// FailedPrecondition specifies that the system is not in the state required by the
+	// operation; such as deleting a non-empty directory.
+	//
+	// Its default level is ERROR.
+	FailedPrecondition // level:"ERROR" http:"400" doc:"https://pkg.go.dev/github.com/PlayerR9/go-fault#FailedPrecondition"
+
+	// Aborted specifies that an operation was aborted because of a concurrency conflict;
+	// such as a failed transaction. Retrying at a higher level may succeed.
+	//
+	// Its default level is WARNING.
+	Aborted // level:"WARNING" http:"409" doc:"https://pkg.go.dev/github.com/PlayerR9/go-fault#Aborted"
+
+	// OutOfRange specifies that an operation was attempted past a valid range; such as
+	// reading past the end of a file.
+	//
+	// Its default level is ERROR.
+	OutOfRange // level:"ERROR" http:"400" doc:"https://pkg.go.dev/github.com/PlayerR9/go-fault#OutOfRange"
+
+	// Unimplemented specifies that an operation is not implemented or not supported.
+	//
+	// Its default level is ERROR.
+	Unimplemented // level:"ERROR" http:"501" doc:"https://pkg.go.dev/github.com/PlayerR9/go-fault#Unimplemented"
+
+	// Internal specifies that an invariant of the system has been broken. It signals a bug.
+	//
+	// Its default level is ERROR.
+	Internal // level:"ERROR" http:"500" doc:"https://pkg.go.dev/github.com/PlayerR9/go-fault#Internal"
+
+	// DataLoss specifies an unrecoverable loss or corruption of data.
+	//
+	// Its default level is FATAL.
+	DataLoss // level:"FATAL" http:"500" doc:"https://pkg.go.dev/github.com/PlayerR9/go-fault#DataLoss"
 )
 
 var (
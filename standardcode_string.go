@@ -1,24 +1,355 @@
-// Code generated by "stringer -type=StandardCode"; DO NOT EDIT.
+// Code generated by "faultcodegen -type=StandardCode"; DO NOT EDIT.
 
 package fault
 
-import "strconv"
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
 
 func _() {
 	// An "invalid array index" compiler error signifies that the constant values have changed.
-	// Re-run the stringer command to generate them again.
+	// Re-run the faultcodegen command to generate them again.
 	var x [1]struct{}
-	_ = x[Invalid - -1]
+	_ = x[Invalid-(-1)]
+	_ = x[UnknownCode-(0)]
+	_ = x[FaultJoin-(1)]
+	_ = x[BadParameter-(2)]
+	_ = x[OperationFailed-(3)]
+	_ = x[NotFound-(4)]
+	_ = x[AlreadyExists-(5)]
+	_ = x[PermissionDenied-(6)]
+	_ = x[Unauthenticated-(7)]
+	_ = x[Timeout-(8)]
+	_ = x[Canceled-(9)]
+	_ = x[Unavailable-(10)]
+	_ = x[ResourceExhausted-(11)]
+	_ = x[FailedPrecondition-(12)]
+	_ = x[Aborted-(13)]
+	_ = x[OutOfRange-(14)]
+	_ = x[Unimplemented-(15)]
+	_ = x[Internal-(16)]
+	_ = x[DataLoss-(17)]
 }
 
-const _StandardCode_name = "Invalid"
+// _StandardCode_values are the values of StandardCode, in order of declaration.
+var _StandardCode_values = [...]StandardCode{
+	Invalid,
+	UnknownCode,
+	FaultJoin,
+	BadParameter,
+	OperationFailed,
+	NotFound,
+	AlreadyExists,
+	PermissionDenied,
+	Unauthenticated,
+	Timeout,
+	Canceled,
+	Unavailable,
+	ResourceExhausted,
+	FailedPrecondition,
+	Aborted,
+	OutOfRange,
+	Unimplemented,
+	Internal,
+	DataLoss,
+}
 
-var _StandardCode_index = [...]uint8{0, 7}
+// _StandardCode_names are the names of the values of StandardCode.
+var _StandardCode_names = [...]string{
+	"Invalid",
+	"UnknownCode",
+	"FaultJoin",
+	"BadParameter",
+	"OperationFailed",
+	"NotFound",
+	"AlreadyExists",
+	"PermissionDenied",
+	"Unauthenticated",
+	"Timeout",
+	"Canceled",
+	"Unavailable",
+	"ResourceExhausted",
+	"FailedPrecondition",
+	"Aborted",
+	"OutOfRange",
+	"Unimplemented",
+	"Internal",
+	"DataLoss",
+}
 
+// _StandardCode_infos are the metadata of the values of StandardCode.
+var _StandardCode_infos = [...]CodeInfo{
+	{
+		Description: "Invalid specifies when faults are constructed in a way that is not expected.",
+		Level:       FATAL,
+		HTTPStatus:  500,
+		DocURL:      "https://pkg.go.dev/github.com/PlayerR9/go-fault#Invalid",
+	},
+	{
+		Description: "UnknownCode specifies when a fault is created but no code was specified.",
+		Level:       ERROR,
+		HTTPStatus:  500,
+		DocURL:      "https://pkg.go.dev/github.com/PlayerR9/go-fault#UnknownCode",
+	},
+	{
+		Description: "FaultJoin is the fault code for when a fault is joined.",
+		Level:       ERROR,
+		HTTPStatus:  500,
+		DocURL:      "https://pkg.go.dev/github.com/PlayerR9/go-fault#FaultJoin",
+	},
+	{
+		Description: "BadParameter specifies a broad category of faults that are caused by unexpected parameters.",
+		Level:       ERROR,
+		HTTPStatus:  400,
+		DocURL:      "https://pkg.go.dev/github.com/PlayerR9/go-fault#BadParameter",
+	},
+	{
+		Description: "OperationFailed specifies a broad category of faults that are returned by functions when they fail.",
+		Level:       ERROR,
+		HTTPStatus:  500,
+		DocURL:      "https://pkg.go.dev/github.com/PlayerR9/go-fault#OperationFailed",
+	},
+	{
+		Description: "NotFound specifies that a requested entity, such as a file or a record, does not exist.",
+		Level:       ERROR,
+		HTTPStatus:  404,
+		DocURL:      "https://pkg.go.dev/github.com/PlayerR9/go-fault#NotFound",
+	},
+	{
+		Description: "AlreadyExists specifies that an entity that was meant to be created already exists.",
+		Level:       ERROR,
+		HTTPStatus:  409,
+		DocURL:      "https://pkg.go.dev/github.com/PlayerR9/go-fault#AlreadyExists",
+	},
+	{
+		Description: "PermissionDenied specifies that the caller is identified but not allowed to perform the operation.",
+		Level:       ERROR,
+		HTTPStatus:  403,
+		DocURL:      "https://pkg.go.dev/github.com/PlayerR9/go-fault#PermissionDenied",
+	},
+	{
+		Description: "Unauthenticated specifies that the caller could not be identified; such as when its credentials are missing or invalid.",
+		Level:       ERROR,
+		HTTPStatus:  401,
+		DocURL:      "https://pkg.go.dev/github.com/PlayerR9/go-fault#Unauthenticated",
+	},
+	{
+		Description: "Timeout specifies that an operation did not complete before its deadline.",
+		Level:       ERROR,
+		HTTPStatus:  504,
+		DocURL:      "https://pkg.go.dev/github.com/PlayerR9/go-fault#Timeout",
+	},
+	{
+		Description: "Canceled specifies that an operation was canceled, usually by the caller.",
+		Level:       NOTICE,
+		HTTPStatus:  499,
+		DocURL:      "https://pkg.go.dev/github.com/PlayerR9/go-fault#Canceled",
+	},
+	{
+		Description: "Unavailable specifies that a service is temporarily unable to handle the operation.",
+		Level:       ERROR,
+		HTTPStatus:  503,
+		DocURL:      "https://pkg.go.dev/github.com/PlayerR9/go-fault#Unavailable",
+	},
+	{
+		Description: "ResourceExhausted specifies that a resource, such as a quota or the disk space, has run out.",
+		Level:       WARNING,
+		HTTPStatus:  429,
+		DocURL:      "https://pkg.go.dev/github.com/PlayerR9/go-fault#ResourceExhausted",
+	},
+	{
+		Description: "FailedPrecondition specifies that the system is not in the state required by the operation; such as deleting a non-empty directory.",
+		Level:       ERROR,
+		HTTPStatus:  400,
+		DocURL:      "https://pkg.go.dev/github.com/PlayerR9/go-fault#FailedPrecondition",
+	},
+	{
+		Description: "Aborted specifies that an operation was aborted because of a concurrency conflict; such as a failed transaction.",
+		Level:       WARNING,
+		HTTPStatus:  409,
+		DocURL:      "https://pkg.go.dev/github.com/PlayerR9/go-fault#Aborted",
+	},
+	{
+		Description: "OutOfRange specifies that an operation was attempted past a valid range; such as reading past the end of a file.",
+		Level:       ERROR,
+		HTTPStatus:  400,
+		DocURL:      "https://pkg.go.dev/github.com/PlayerR9/go-fault#OutOfRange",
+	},
+	{
+		Description: "Unimplemented specifies that an operation is not implemented or not supported.",
+		Level:       ERROR,
+		HTTPStatus:  501,
+		DocURL:      "https://pkg.go.dev/github.com/PlayerR9/go-fault#Unimplemented",
+	},
+	{
+		Description: "Internal specifies that an invariant of the system has been broken.",
+		Level:       ERROR,
+		HTTPStatus:  500,
+		DocURL:      "https://pkg.go.dev/github.com/PlayerR9/go-fault#Internal",
+	},
+	{
+		Description: "DataLoss specifies an unrecoverable loss or corruption of data.",
+		Level:       FATAL,
+		HTTPStatus:  500,
+		DocURL:      "https://pkg.go.dev/github.com/PlayerR9/go-fault#DataLoss",
+	},
+}
+
+// _StandardCode_index returns the index of the value in the tables of StandardCode; -1 if the
+// value is unknown.
+func _StandardCode_index(i StandardCode) int {
+	switch i {
+	case Invalid:
+		return 0
+	case UnknownCode:
+		return 1
+	case FaultJoin:
+		return 2
+	case BadParameter:
+		return 3
+	case OperationFailed:
+		return 4
+	case NotFound:
+		return 5
+	case AlreadyExists:
+		return 6
+	case PermissionDenied:
+		return 7
+	case Unauthenticated:
+		return 8
+	case Timeout:
+		return 9
+	case Canceled:
+		return 10
+	case Unavailable:
+		return 11
+	case ResourceExhausted:
+		return 12
+	case FailedPrecondition:
+		return 13
+	case Aborted:
+		return 14
+	case OutOfRange:
+		return 15
+	case Unimplemented:
+		return 16
+	case Internal:
+		return 17
+	case DataLoss:
+		return 18
+	default:
+		return -1
+	}
+}
+
+// String implements the fmt.Stringer interface.
 func (i StandardCode) String() string {
-	i -= -1
-	if i < 0 || i >= StandardCode(len(_StandardCode_index)-1) {
-		return "StandardCode(" + strconv.FormatInt(int64(i+-1), 10) + ")"
+	idx := _StandardCode_index(i)
+	if idx < 0 {
+		return "StandardCode(" + strconv.FormatInt(int64(i), 10) + ")"
 	}
-	return _StandardCode_name[_StandardCode_index[i]:_StandardCode_index[i+1]]
+
+	return _StandardCode_names[idx]
+}
+
+// ParseStandardCode parses a StandardCode from its name, as given by String, or from the
+// name of its constant. Unknown values, such as "StandardCode(42)", are accepted as well.
+func ParseStandardCode(str string) (StandardCode, error) {
+	str = strings.TrimSpace(str)
+
+	switch str {
+	case "Invalid":
+		return Invalid, nil
+	case "UnknownCode":
+		return UnknownCode, nil
+	case "FaultJoin":
+		return FaultJoin, nil
+	case "BadParameter":
+		return BadParameter, nil
+	case "OperationFailed":
+		return OperationFailed, nil
+	case "NotFound":
+		return NotFound, nil
+	case "AlreadyExists":
+		return AlreadyExists, nil
+	case "PermissionDenied":
+		return PermissionDenied, nil
+	case "Unauthenticated":
+		return Unauthenticated, nil
+	case "Timeout":
+		return Timeout, nil
+	case "Canceled":
+		return Canceled, nil
+	case "Unavailable":
+		return Unavailable, nil
+	case "ResourceExhausted":
+		return ResourceExhausted, nil
+	case "FailedPrecondition":
+		return FailedPrecondition, nil
+	case "Aborted":
+		return Aborted, nil
+	case "OutOfRange":
+		return OutOfRange, nil
+	case "Unimplemented":
+		return Unimplemented, nil
+	case "Internal":
+		return Internal, nil
+	case "DataLoss":
+		return DataLoss, nil
+	}
+
+	digits, ok := strings.CutPrefix(str, "StandardCode(")
+	if ok {
+		digits, ok = strings.CutSuffix(digits, ")")
+	}
+
+	if ok {
+		value, err := strconv.ParseInt(digits, 10, 64)
+		if err == nil {
+			return StandardCode(value), nil
+		}
+	}
+
+	return 0, fmt.Errorf("unknown StandardCode (%q)", str)
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+func (i StandardCode) MarshalText() ([]byte, error) {
+	return []byte(i.String()), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface. The text is parsed
+// with ParseStandardCode.
+func (i *StandardCode) UnmarshalText(text []byte) error {
+	if i == nil {
+		return fmt.Errorf("receiver must be non-nil")
+	}
+
+	value, err := ParseStandardCode(string(text))
+	if err != nil {
+		return err
+	}
+
+	*i = value
+
+	return nil
+}
+
+// StandardCodeValues returns the values of StandardCode, in order of declaration.
+func StandardCodeValues() []StandardCode {
+	values := _StandardCode_values
+
+	return values[:]
+}
+
+// Info implements the CodeInfoer interface.
+func (i StandardCode) Info() CodeInfo {
+	idx := _StandardCode_index(i)
+	if idx < 0 {
+		return CodeInfo{Level: UnknownLevel}
+	}
+
+	return _StandardCode_infos[idx]
 }